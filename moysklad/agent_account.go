@@ -20,6 +20,8 @@ type AgentAccount struct {
 	IsDefault            *bool      `json:"isDefault,omitempty"`            // Является ли счет основным счетом Контрагента
 	Meta                 *Meta      `json:"meta,omitempty"`                 // Метаданные Счета Контрагента
 	Updated              *Timestamp `json:"updated,omitempty"`              // Момент последнего обновления
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Application Серверное приложение.
@@ -18,6 +19,8 @@ type Application struct {
 	Name      *string `json:"name,omitempty"`      // Наименование Серверного приложения
 	Meta      *Meta   `json:"meta,omitempty"`      // Метаданные Серверного приложения
 	AppUID    *string `json:"appUid,omitempty"`    // UID Серверного приложения
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -67,6 +70,11 @@ type ApplicationService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Application], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех установленных приложений постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Application, error]
+
 	// GetByID выполняет запрос на получение сущности установленного приложения.
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает объект Application.
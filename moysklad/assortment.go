@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/go-resty/resty/v2"
+	"iter"
 	"reflect"
 )
 
@@ -211,6 +212,8 @@ type AssortmentSettings struct {
 	BarcodeRules    *BarcodeRules    `json:"barcodeRules,omitempty"`    // Настройки правил штрихкодов для сущностей справочника
 	UniqueCodeRules *UniqueCodeRules `json:"uniqueCodeRules,omitempty"` // Настройки уникальности кода для сущностей справочника
 	CreatedShared   *bool            `json:"createdShared,omitempty"`   // Создавать новые документы с меткой «Общий»
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetMeta возвращает Метаданные Настроек справочника.
@@ -417,12 +420,12 @@ func NewBarcodes() Slice[Barcode] {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-towar-towary-atributy-wlozhennyh-suschnostej-zakupochnaq-cena
 type BuyPrice struct {
-	Value    *float64  `json:"value,omitempty"`    // Значение цены
+	Value    *Decimal  `json:"value,omitempty"`    // Значение цены
 	Currency *Currency `json:"currency,omitempty"` // Метаданные валюты
 }
 
 // GetValue возвращает Значение цены.
-func (buyPrice BuyPrice) GetValue() float64 {
+func (buyPrice BuyPrice) GetValue() Decimal {
 	return Deref(buyPrice.Value)
 }
 
@@ -432,7 +435,7 @@ func (buyPrice BuyPrice) GetCurrency() Currency {
 }
 
 // SetValue устанавливает Значение цены.
-func (buyPrice *BuyPrice) SetValue(value *float64) *BuyPrice {
+func (buyPrice *BuyPrice) SetValue(value *Decimal) *BuyPrice {
 	buyPrice.Value = value
 	return buyPrice
 }
@@ -454,12 +457,12 @@ func (buyPrice BuyPrice) String() string {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-towar-towary-atributy-wlozhennyh-suschnostej-minimal-naq-cena
 type MinPrice struct {
-	Value    *float64  `json:"value,omitempty"`    // Значение цены
+	Value    *Decimal  `json:"value,omitempty"`    // Значение цены
 	Currency *Currency `json:"currency,omitempty"` // Ссылка на валюту в формате Метаданных
 }
 
 // GetValue возвращает Значение цены.
-func (minPrice MinPrice) GetValue() float64 {
+func (minPrice MinPrice) GetValue() Decimal {
 	return Deref(minPrice.Value)
 }
 
@@ -469,7 +472,7 @@ func (minPrice MinPrice) GetCurrency() Currency {
 }
 
 // SetValue устанавливает Значение цены.
-func (minPrice *MinPrice) SetValue(value float64) *MinPrice {
+func (minPrice *MinPrice) SetValue(value Decimal) *MinPrice {
 	minPrice.Value = &value
 	return minPrice
 }
@@ -493,13 +496,13 @@ func (minPrice MinPrice) String() string {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-towar-towary-atributy-wlozhennyh-suschnostej-ceny-prodazhi
 type SalePrice struct {
-	Value     *float64   `json:"value,omitempty"`     // Значение цены
+	Value     *Decimal   `json:"value,omitempty"`     // Значение цены
 	Currency  *Currency  `json:"currency,omitempty"`  // Ссылка на валюту в формате Метаданных
 	PriceType *PriceType `json:"priceType,omitempty"` // Тип цены
 }
 
 // GetValue возвращает Значение цены.
-func (salePrice SalePrice) GetValue() float64 {
+func (salePrice SalePrice) GetValue() Decimal {
 	return Deref(salePrice.Value)
 }
 
@@ -514,7 +517,7 @@ func (salePrice SalePrice) GetPriceType() PriceType {
 }
 
 // SetValue устанавливает Значение цены.
-func (salePrice *SalePrice) SetValue(value float64) *SalePrice {
+func (salePrice *SalePrice) SetValue(value Decimal) *SalePrice {
 	salePrice.Value = &value
 	return salePrice
 }
@@ -546,11 +549,11 @@ func (salePrice SalePrice) String() string {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/#mojsklad-json-api-obschie-swedeniq-ostatki-i-sebestoimost-w-poziciqh-dokumentow
 type Stock struct {
-	Cost      float64 `json:"cost"`      // Себестоимость
-	Quantity  float64 `json:"quantity"`  // Количество
-	Reserve   float64 `json:"reserve"`   // Резерв
-	InTransit float64 `json:"intransit"` // Ожидание
-	Available float64 `json:"available"` // Доступно
+	Cost      Decimal `json:"cost"`      // Себестоимость
+	Quantity  Decimal `json:"quantity"`  // Количество
+	Reserve   Decimal `json:"reserve"`   // Резерв
+	InTransit Decimal `json:"intransit"` // Ожидание
+	Available Decimal `json:"available"` // Доступно
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -661,6 +664,11 @@ type AssortmentService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (Assortment, *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех товаров, услуг, комплектов, модификаций и серий постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*AssortmentPosition, error]
+
 	// GetListAsync выполняет асинхронный запрос на получение всех товаров, услуг, комплектов, модификаций и серий в виде списка.
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает готовый сервис AsyncResultService для обработки данного запроса.
@@ -698,9 +706,16 @@ func (service *assortmentService) GetList(ctx context.Context, params ...func(*P
 func (service *assortmentService) GetListAll(ctx context.Context, params ...func(*Params)) (Assortment, *resty.Response, error) {
 	ep := &endpointGetList[AssortmentPosition]{service.Endpoint}
 	aps, resp, err := ep.GetListAll(ctx, params...)
+	if aps == nil {
+		return nil, resp, err
+	}
 	return Assortment(*aps), resp, err
 }
 
+func (service *assortmentService) GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*AssortmentPosition, error] {
+	return listSeq[AssortmentPosition](ctx, service.client, service.uri, params)
+}
+
 func (service *assortmentService) GetListAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[AssortmentResponse], *resty.Response, error) {
 	params = append(params, WithAsync())
 
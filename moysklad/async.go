@@ -135,7 +135,9 @@ func (service *asyncResultService[T]) ResultURL() string {
 }
 
 func (service *asyncResultService[T]) Check(ctx context.Context) (bool, *resty.Response, error) {
-	async, resp, err := NewRequestBuilder[Async](service.client, service.StatusURL()).Get(ctx)
+	service.client.metrics.IncAsyncPoll()
+
+	async, resp, err := NewRequestBuilder[Async](service.client, service.client.hrefPath(service.StatusURL())).Get(ctx)
 	if err != nil {
 		return false, resp, err
 	}
@@ -143,7 +145,7 @@ func (service *asyncResultService[T]) Check(ctx context.Context) (bool, *resty.R
 }
 
 func (service *asyncResultService[T]) Result(ctx context.Context) (*T, *resty.Response, error) {
-	data, resp, err := NewRequestBuilder[T](service.client, service.ResultURL()).Get(ctx)
+	data, resp, err := NewRequestBuilder[T](service.client, service.client.hrefPath(service.ResultURL())).Get(ctx)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -151,7 +153,7 @@ func (service *asyncResultService[T]) Result(ctx context.Context) (*T, *resty.Re
 }
 
 func (service *asyncResultService[T]) Cancel(ctx context.Context) (bool, *resty.Response, error) {
-	path := fmt.Sprintf(EndpointAsyncCancel, service.StatusURL())
+	path := fmt.Sprintf(EndpointAsyncCancel, service.client.hrefPath(service.StatusURL()))
 	_, resp, err := NewRequestBuilder[any](service.client, path).Post(ctx, nil)
 	if err != nil {
 		return false, resp, err
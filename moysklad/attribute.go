@@ -19,6 +19,8 @@ type Attribute struct {
 	File             *NullValue[AttributeFile] `json:"file,omitempty"`             // Описание файла и контент (поле доступно только для доп.поля типа Файл)
 	Download         *Meta                     `json:"download,omitempty"`         // Метаданные, содержащие ссылку на скачивание файла. Поле отображается только для типа доп поля AttributeTypeFile (Файл)
 	Type             AttributeType             `json:"type,omitempty"`             // Тип доп. поля [Обязательное при ответе] [Необходимо при создании] [После заполнения недоступно для изменения]
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetCustomEntityMeta возвращает Метаданные пользовательского справочника.
@@ -5,7 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
-	"log"
+	"iter"
+	"log/slog"
 )
 
 // Audit Контексты Аудита.
@@ -133,9 +134,9 @@ type AuditPosition struct {
 		Name string `json:"name"`
 	} `json:"assortment"` // Метаданные позиции
 	Uom      string  `json:"uom"`      // Единица измерения
-	Quantity float64 `json:"quantity"` // Количество
-	Reserve  float64 `json:"reserve"`  // Резерв
-	Price    float64 `json:"price"`    // Стоимость позиции в документе
+	Quantity Decimal `json:"quantity"` // Количество
+	Reserve  Decimal `json:"reserve"`  // Резерв
+	Price    Decimal `json:"price"`    // Стоимость позиции в документе
 	Discount float64 `json:"discount"` // Скидка позиции в документе
 }
 
@@ -168,12 +169,12 @@ func (diff Diff) GetSalesPrices() (bool, SalePriceElem) {
 		var t OldNew[[]any]
 		b, err := json.Marshal(salePrices)
 		if err != nil {
-			log.Println(err)
+			slog.Debug("moysklad: marshal diff field", slog.String("field", "salePrices"), slog.Any("error", err))
 			return false, o
 		}
 
 		if err = json.Unmarshal(b, &t); err != nil {
-			log.Println(err)
+			slog.Debug("moysklad: unmarshal diff field", slog.String("field", "salePrices"), slog.Any("error", err))
 			return false, o
 		}
 
@@ -266,6 +267,11 @@ type AuditService interface {
 	// Возвращает объект List.
 	GetContexts(ctx context.Context, params ...func(*Params)) (*List[Audit], *resty.Response, error)
 
+	// GetContextsSeq возвращает итератор для получения Контекстов Аудита постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetContextsSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Audit, error]
+
 	// GetEvents выполняет запрос на получение Событий по Контексту AuditEvent.
 	// Принимает контекст и ID контекста Аудита.
 	// Возвращает объект List.
@@ -291,6 +297,12 @@ func (service *auditService) GetContexts(ctx context.Context, params ...func(*Pa
 	return NewRequestBuilder[List[Audit]](service.client, service.uri).SetParams(params).Get(ctx)
 }
 
+func (service *auditService) GetContextsSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Audit, error] {
+	// по умолчанию контексты аудита возвращаются по 25, максимальный размер страницы – 100
+	params = append([]func(*Params){WithLimit(100)}, params...)
+	return listSeq[Audit](ctx, service.client, service.uri, params)
+}
+
 func (service *auditService) GetEvents(ctx context.Context, id string) (*List[AuditEvent], *resty.Response, error) {
 	path := fmt.Sprintf(EndpointAuditEvents, id)
 	return NewRequestBuilder[List[AuditEvent]](service.client, path).Get(ctx)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // BonusProgram Бонусная программа.
@@ -29,6 +30,8 @@ type BonusProgram struct {
 	WelcomeBonusesEnabled     *bool              `json:"welcomeBonusesEnabled,omitempty"`     // Возможность начисления приветственных баллов
 	WelcomeBonusesMode        WelcomeBonusesMode `json:"welcomeBonusesMode,omitempty"`        // Условие начисления приветственных баллов. Не может быть пустым, если welcomeBonusesEnabled = true.
 	AgentTags                 Slice[string]      `json:"agentTags,omitempty"`                 // Теги контрагентов, к которым применяется бонусная программа. В случае пустого значения контрагентов в результате выводится пустой массив.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -272,6 +275,11 @@ type BonusProgramService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[BonusProgram], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех бонусных программ постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*BonusProgram, error]
+
 	// Create выполняет запрос на создание бонусной программы.
 	// Обязательные поля для заполнения:
 	//	- name (имя бонусной программы)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -37,6 +38,8 @@ type BonusTransaction struct {
 	TransactionType   BonusTransactionType     `json:"transactionType,omitempty"`   // Тип бонусной операции. Возможные значения: EARNING, SPENDING
 	TransactionStatus BonusTransactionStatus   `json:"transactionStatus,omitempty"` // Статус бонусной операции. Возможные значения: WAIT_PROCESSING, COMPLETED, CANCELED
 	CategoryType      BonusTransactionCategory `json:"categoryType,omitempty"`      // Категория бонусной операции. Возможные значения: REGULAR, WELCOME
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -355,6 +358,11 @@ type BonusTransactionService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[BonusTransaction], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех бонусных операций постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*BonusTransaction, error]
+
 	// Create выполняет запрос на создание бонусной операции.
 	// Обязательные поля для заполнения:
 	//	- agent (Метаданные Контрагента, связанного с бонусной операцией)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -55,6 +56,8 @@ type Bundle struct {
 	Barcodes            Slice[Barcode]              `json:"barcodes,omitempty"`            // Штрихкоды
 	SalePrices          Slice[SalePrice]            `json:"salePrices,omitempty"`          // Цены продажи
 	Attributes          Slice[Attribute]            `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // NewBundleFromAssortment пытается привести переданный в качестве аргумента [AssortmentPosition] к типу [Bundle].
@@ -538,12 +541,12 @@ func (bundle *Bundle) Delete(ctx context.Context, client *Client) (bool, *resty.
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-komplekt-komplekty-atributy-wlozhennyh-suschnostej-dopolnitel-nye-rashody
 type BundleOverhead struct {
-	Value    *float64  `json:"value,omitempty"`    // Значение цены
+	Value    *Decimal  `json:"value,omitempty"`    // Значение цены
 	Currency *Currency `json:"currency,omitempty"` // Метаданные валюты
 }
 
 // GetValue возвращает Значение цены.
-func (bundleOverhead BundleOverhead) GetValue() float64 {
+func (bundleOverhead BundleOverhead) GetValue() Decimal {
 	return Deref(bundleOverhead.Value)
 }
 
@@ -553,7 +556,7 @@ func (bundleOverhead BundleOverhead) GetCurrency() Currency {
 }
 
 // SetValue устанавливает Значение цены.
-func (bundleOverhead *BundleOverhead) SetValue(value *float64) *BundleOverhead {
+func (bundleOverhead *BundleOverhead) SetValue(value *Decimal) *BundleOverhead {
 	bundleOverhead.Value = value
 	return bundleOverhead
 }
@@ -582,12 +585,14 @@ type BundleComponent struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги, которую представляет собой компонент
 	ID         *string             `json:"id,omitempty"`         // ID компонента
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в компоненте
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в компоненте
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // NewBundleComponent принимает объект, реализующий интерфейс [AssortmentConverter] и количество.
 // Возвращает новый компонент комплекта.
-func NewBundleComponent(assortment AssortmentConverter, quantity float64) *BundleComponent {
+func NewBundleComponent(assortment AssortmentConverter, quantity Decimal) *BundleComponent {
 	return &BundleComponent{Assortment: assortment.AsAssortment(), Quantity: &quantity}
 }
 
@@ -607,7 +612,7 @@ func (bundleComponent BundleComponent) GetID() string {
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в компоненте.
-func (bundleComponent BundleComponent) GetQuantity() float64 {
+func (bundleComponent BundleComponent) GetQuantity() Decimal {
 	return Deref(bundleComponent.Quantity)
 }
 
@@ -622,7 +627,7 @@ func (bundleComponent *BundleComponent) SetAssortment(assortment AssortmentConve
 }
 
 // SetQuantity устанавливает Количество товаров/услуг данного вида в компоненте.
-func (bundleComponent *BundleComponent) SetQuantity(quantity float64) *BundleComponent {
+func (bundleComponent *BundleComponent) SetQuantity(quantity Decimal) *BundleComponent {
 	bundleComponent.Quantity = &quantity
 	return bundleComponent
 }
@@ -649,6 +654,11 @@ type BundleService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Bundle], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех комплектов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Bundle, error]
+
 	// Create выполняет запрос на создание бонусной программы.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование комплекта)
@@ -0,0 +1,344 @@
+package moysklad
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheConfig конфигурация кэширования ответов на запросы справочников.
+//
+// Кэшируются успешные GET-запросы к перечисленным типам сущностей: GetByID, GetList, FetchMeta и другие.
+//
+// # Пример:
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token: "MS_TOKEN_HERE",
+//		Cache: &moysklad.CacheConfig{
+//			Types: []moysklad.MetaType{moysklad.MetaTypeCurrency, moysklad.MetaTypeUom, moysklad.MetaTypeStore},
+//			TTL:   10 * time.Minute,
+//		},
+//	})
+type CacheConfig struct {
+	// Типы сущностей, ответы на запросы которых кэшируются.
+	//
+	// Кэшируются запросы по адресам entity/<тип>, а также типы цен [MetaTypePriceType]
+	// (context/companysettings/pricetype) и статусы документов [MetaTypeState] (entity/<тип>/metadata/states).
+	// Типы, которые запрашиваются по другим адресам (например, отчёты), не кэшируются.
+	Types []MetaType
+
+	// Типы сущностей, ответы на запросы метаданных которых (например, статусы документов) кэшируются.
+	MetadataTypes []MetaType
+
+	// Время жизни записи. По умолчанию 5 минут.
+	TTL time.Duration
+
+	// Максимальное количество записей кэша в памяти по умолчанию. По умолчанию 1000.
+	MaxEntries int
+
+	// Хранилище кэша. По умолчанию используется хранилище в памяти [NewMemoryCache].
+	Store Cache
+
+	// Префикс ключей, позволяющий использовать одно хранилище для нескольких учётных записей.
+	//
+	// Клиенты пула [Pool] дополняют префикс идентификатором учётной записи.
+	Namespace string
+}
+
+// CacheEntry запись кэша – сохранённый ответ на запрос.
+type CacheEntry struct {
+	StatusCode int         // HTTP-статус ответа
+	Header     http.Header // Заголовки ответа
+	Body       []byte      // Тело ответа
+}
+
+// Cache описывает хранилище кэша ответов.
+//
+// Записи группируются по типу сущности group, что позволяет удалять все записи типа
+// при изменении любого объекта этого типа. Реализация должна быть безопасной для использования
+// из нескольких горутин; ошибки внешнего хранилища следует трактовать как отсутствие записи.
+type Cache interface {
+	// Get возвращает запись по ключу key.
+	Get(ctx context.Context, key string) (*CacheEntry, bool)
+
+	// Set сохраняет запись entry по ключу key в группе group на время ttl.
+	Set(ctx context.Context, group, key string, entry *CacheEntry, ttl time.Duration)
+
+	// Invalidate удаляет все записи группы group.
+	Invalidate(ctx context.Context, group string)
+}
+
+const (
+	defaultCacheTTL        = 5 * time.Minute // Время жизни записи кэша по умолчанию
+	defaultCacheMaxEntries = 1000            // Максимальное количество записей кэша в памяти по умолчанию
+	cacheGroupMetadata     = "/metadata"     // Суффикс группы записей метаданных
+)
+
+// responseCache кэш ответов клиента.
+type responseCache struct {
+	store         Cache
+	types         []string
+	metadataTypes []string
+	ttl           time.Duration
+	namespace     string
+}
+
+func newResponseCache(config *CacheConfig) *responseCache {
+	cache := &responseCache{
+		store:     config.Store,
+		ttl:       config.TTL,
+		namespace: config.Namespace,
+	}
+
+	if cache.ttl <= 0 {
+		cache.ttl = defaultCacheTTL
+	}
+
+	if cache.store == nil {
+		maxEntries := config.MaxEntries
+		if maxEntries <= 0 {
+			maxEntries = defaultCacheMaxEntries
+		}
+		cache.store = NewMemoryCache(maxEntries)
+	}
+
+	for _, metaType := range config.Types {
+		cache.types = append(cache.types, metaType.String())
+	}
+
+	for _, metaType := range config.MetadataTypes {
+		cache.metadataTypes = append(cache.metadataTypes, metaType.String())
+	}
+
+	return cache
+}
+
+// group возвращает группу записей для запроса info и тип сущности, записи которого удаляются
+// при изменяющем запросе, или пустые строки, если запрос не кэшируется.
+func (cache *responseCache) group(info *RequestInfo) (group, entity string) {
+	segments := uriSegments(info.URI)
+
+	// типы цен хранятся в настройках компании: изменение настроек также удаляет записи типов цен
+	if len(segments) > 1 && strings.Join(segments[:2], "/") == EndpointCompanySettings {
+		isPriceType := len(segments) > 2 && segments[2] == MetaTypePriceType.String()
+		if (isPriceType || info.Method != http.MethodGet) && slices.Contains(cache.types, MetaTypePriceType.String()) {
+			return cache.namespace + MetaTypePriceType.String(), MetaTypePriceType.String()
+		}
+		return "", ""
+	}
+
+	if len(segments) < 2 || segments[0]+"/" != EndpointEntity {
+		return "", ""
+	}
+
+	entity = segments[1]
+
+	if len(segments) > 2 && segments[2] == "metadata" {
+		// статусы документов
+		if len(segments) > 3 && segments[3] == "states" && slices.Contains(cache.types, MetaTypeState.String()) {
+			return cache.namespace + MetaTypeState.String(), entity
+		}
+		if slices.Contains(cache.metadataTypes, entity) {
+			return cache.namespace + entity + cacheGroupMetadata, entity
+		}
+		return "", ""
+	}
+
+	if slices.Contains(cache.types, entity) {
+		return cache.namespace + entity, entity
+	}
+	return "", ""
+}
+
+// key возвращает ключ записи для запроса info.
+func (cache *responseCache) key(group string, info *RequestInfo) string {
+	key := group + " " + info.URI
+	if len(info.Params) > 0 {
+		key += "?" + info.Params.Encode()
+	}
+	return key
+}
+
+// invalidate удаляет записи сущностей и метаданных типа entity.
+func (cache *responseCache) invalidate(ctx context.Context, entity string) {
+	cache.store.Invalidate(ctx, cache.namespace+entity)
+	cache.store.Invalidate(ctx, cache.namespace+entity+cacheGroupMetadata)
+}
+
+// handler возвращает [Handler], который отвечает на кэшируемые запросы из кэша
+// и удаляет записи типа сущности после успешного изменяющего запроса.
+func (cache *responseCache) handler(next Handler) Handler {
+	return func(ctx context.Context, info *RequestInfo) (*resty.Response, error) {
+		group, entity := cache.group(info)
+		if group == "" {
+			return next(ctx, info)
+		}
+
+		if info.Method != http.MethodGet {
+			resp, err := next(ctx, info)
+			if err == nil && resp != nil && resp.IsSuccess() {
+				cache.invalidate(ctx, entity)
+
+				// группа статусов документов не входит в записи типа сущности
+				if group != cache.namespace+entity && group != cache.namespace+entity+cacheGroupMetadata {
+					cache.store.Invalidate(ctx, group)
+				}
+			}
+			return resp, err
+		}
+
+		key := cache.key(group, info)
+
+		if entry, ok := cache.store.Get(ctx, key); ok {
+			return entry.response(info.Request), nil
+		}
+
+		resp, err := next(ctx, info)
+		if err == nil && resp != nil && resp.StatusCode() == http.StatusOK {
+			cache.store.Set(ctx, group, key, &CacheEntry{
+				StatusCode: resp.StatusCode(),
+				Header:     resp.Header().Clone(),
+				Body:       bytes.Clone(resp.Body()),
+			}, cache.ttl)
+		}
+
+		return resp, err
+	}
+}
+
+// response возвращает ответ на запрос request, восстановленный из записи кэша.
+// Ответ получает собственную копию тела, поэтому его изменение не затрагивает запись.
+func (entry *CacheEntry) response(request *resty.Request) *resty.Response {
+	resp := &resty.Response{
+		Request: request,
+		RawResponse: &http.Response{
+			Status:     http.StatusText(entry.StatusCode),
+			StatusCode: entry.StatusCode,
+			Header:     entry.Header.Clone(),
+			Request:    request.RawRequest,
+		},
+	}
+	return resp.SetBody(bytes.Clone(entry.Body))
+}
+
+// InvalidateCache удаляет из кэша клиента записи сущностей и метаданных перечисленных типов.
+func (client *Client) InvalidateCache(ctx context.Context, metaTypes ...MetaType) {
+	if client.cache == nil {
+		return
+	}
+
+	for _, metaType := range metaTypes {
+		client.cache.invalidate(ctx, metaType.String())
+	}
+}
+
+// InvalidateCacheByWebhook удаляет из кэша клиента записи типов сущностей, изменение которых
+// описано в уведомлении вебхука notification.
+func (client *Client) InvalidateCacheByWebhook(ctx context.Context, notification *WebhookNotification) {
+	if client.cache == nil || notification == nil {
+		return
+	}
+
+	for _, event := range notification.Events {
+		if event != nil {
+			client.cache.invalidate(ctx, event.Meta.GetType().String())
+		}
+	}
+}
+
+// NewMemoryCache возвращает хранилище кэша в памяти, содержащее не более maxEntries записей.
+// При превышении размера удаляются давно не использованные записи.
+func NewMemoryCache(maxEntries int) Cache {
+	return &memoryCache{
+		maxEntries: max(maxEntries, 1),
+		entries:    make(map[string]*list.Element),
+		groups:     make(map[string]map[string]struct{}),
+		lru:        list.New(),
+	}
+}
+
+type memoryCache struct {
+	maxEntries int
+	entries    map[string]*list.Element
+	groups     map[string]map[string]struct{}
+	lru        *list.List // Записи в порядке использования, начиная с последней
+	mu         sync.Mutex
+}
+
+type memoryCacheItem struct {
+	key       string
+	group     string
+	entry     *CacheEntry
+	expiresAt time.Time
+}
+
+func (cache *memoryCache) Get(_ context.Context, key string) (*CacheEntry, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	element, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	item := element.Value.(*memoryCacheItem)
+	if time.Now().After(item.expiresAt) {
+		cache.remove(element)
+		return nil, false
+	}
+
+	cache.lru.MoveToFront(element)
+	return item.entry, true
+}
+
+func (cache *memoryCache) Set(_ context.Context, group, key string, entry *CacheEntry, ttl time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if element, ok := cache.entries[key]; ok {
+		cache.remove(element)
+	}
+
+	item := &memoryCacheItem{key: key, group: group, entry: entry, expiresAt: time.Now().Add(ttl)}
+	cache.entries[key] = cache.lru.PushFront(item)
+
+	if cache.groups[group] == nil {
+		cache.groups[group] = make(map[string]struct{})
+	}
+	cache.groups[group][key] = struct{}{}
+
+	for cache.lru.Len() > cache.maxEntries {
+		cache.remove(cache.lru.Back())
+	}
+}
+
+func (cache *memoryCache) Invalidate(_ context.Context, group string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for key := range cache.groups[group] {
+		if element, ok := cache.entries[key]; ok {
+			cache.remove(element)
+		}
+	}
+	delete(cache.groups, group)
+}
+
+// remove удаляет запись element. Вызывается под блокировкой.
+func (cache *memoryCache) remove(element *list.Element) {
+	item := cache.lru.Remove(element).(*memoryCacheItem)
+	delete(cache.entries, item.key)
+
+	if keys := cache.groups[item.group]; keys != nil {
+		delete(keys, item.key)
+		if len(keys) == 0 {
+			delete(cache.groups, item.group)
+		}
+	}
+}
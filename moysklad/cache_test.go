@@ -0,0 +1,52 @@
+package moysklad_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+	"github.com/EnOane/go-moysklad/moysklad/moyskladtest"
+)
+
+func TestCache(t *testing.T) {
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{
+		Cache: &moysklad.CacheConfig{Types: []moysklad.MetaType{moysklad.MetaTypeCurrency}},
+	})
+	ctx := context.Background()
+
+	id := server.Add(moysklad.MetaTypeCurrency, &moysklad.Currency{Name: moysklad.String("руб")})
+
+	_, resp, err := client.Entity().Currency().GetByID(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// изменение тела ответа не затрагивает запись кэша
+	clear(resp.Body())
+
+	currency, _, err := client.Entity().Currency().GetByID(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if currency.GetName() != "руб" {
+		t.Errorf("cached currency name = %q, want руб", currency.GetName())
+	}
+	if got := len(server.Requests()); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+
+	// изменение объекта удаляет записи типа
+	if _, _, err = client.Entity().Currency().Update(ctx, id, currency.SetName("RUB")); err != nil {
+		t.Fatal(err)
+	}
+	if currency, _, err = client.Entity().Currency().GetByID(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if currency.GetName() != "RUB" {
+		t.Errorf("currency name after update = %q, want RUB", currency.GetName())
+	}
+	if got := len(server.Requests()); got != 3 {
+		t.Errorf("server received %d requests, want 3", got)
+	}
+}
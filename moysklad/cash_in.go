@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-prihodnyj-order
 type CashIn struct {
 	Organization   *Organization            `json:"organization,omitempty"`   // Метаданные юрлица
-	VatSum         *float64                 `json:"vatSum,omitempty"`         // Сумма НДС
+	VatSum         *Decimal                 `json:"vatSum,omitempty"`         // Сумма НДС
 	Applicable     *bool                    `json:"applicable,omitempty"`     // Отметка о проведении
 	Moment         *Timestamp               `json:"moment,omitempty"`         // Дата документа
 	Code           *string                  `json:"code,omitempty"`           // Код Приходного ордера
@@ -41,12 +42,14 @@ type CashIn struct {
 	SalesChannel   *NullValue[SalesChannel] `json:"salesChannel,omitempty"`   // Метаданные канала продаж
 	Shared         *bool                    `json:"shared,omitempty"`         // Общий доступ
 	State          *NullValue[State]        `json:"state,omitempty"`          // Метаданные статуса Приходного ордера
-	Sum            *float64                 `json:"sum,omitempty"`            // Сумма Приходного ордера в установленной валюте
+	Sum            *Decimal                 `json:"sum,omitempty"`            // Сумма Приходного ордера в установленной валюте
 	SyncID         *string                  `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp               `json:"updated,omitempty"`        // Момент последнего обновления Приходного ордера
 	Name           *string                  `json:"name,omitempty"`           // Наименование Приходного ордера
 	FactureIn      *FactureIn               `json:"factureIn,omitempty"`      // Метаданные Счет-фактуры полученного
 	Attributes     Slice[Attribute]         `json:"attributes,omitempty"`     // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -80,7 +83,7 @@ func (cashIn CashIn) GetOrganization() Organization {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (cashIn CashIn) GetVatSum() float64 {
+func (cashIn CashIn) GetVatSum() Decimal {
 	return Deref(cashIn.VatSum)
 }
 
@@ -205,7 +208,7 @@ func (cashIn CashIn) GetState() State {
 }
 
 // GetSum возвращает Сумму Приходного ордера в установленной валюте.
-func (cashIn CashIn) GetSum() float64 {
+func (cashIn CashIn) GetSum() Decimal {
 	return Deref(cashIn.Sum)
 }
 
@@ -385,7 +388,7 @@ func (cashIn *CashIn) SetState(state *State) *CashIn {
 }
 
 // SetSum устанавливает Сумму Приходного ордера в установленной валюте.
-func (cashIn *CashIn) SetSum(sum *float64) *CashIn {
+func (cashIn *CashIn) SetSum(sum *Decimal) *CashIn {
 	cashIn.Sum = sum
 	return cashIn
 }
@@ -455,6 +458,11 @@ type CashInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CashIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех приходных ордеров постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CashIn, error]
+
 	// Create выполняет запрос на создание приходного ордера.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -43,12 +44,14 @@ type CashOut struct {
 	SalesChannel   *NullValue[SalesChannel] `json:"salesChannel,omitempty"`   // Метаданные канала продаж
 	Shared         *bool                    `json:"shared,omitempty"`         // Общий доступ
 	State          *NullValue[State]        `json:"state,omitempty"`          // Метаданные статуса Расходного ордера
-	Sum            *float64                 `json:"sum,omitempty"`            // Сумма расходного ордера в установленной валюте
+	Sum            *Decimal                 `json:"sum,omitempty"`            // Сумма расходного ордера в установленной валюте
 	SyncID         *string                  `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp               `json:"updated,omitempty"`        // Момент последнего обновления Расходного ордера
-	VatSum         *float64                 `json:"vatSum,omitempty"`         // Сумма НДС
+	VatSum         *Decimal                 `json:"vatSum,omitempty"`         // Сумма НДС
 	FactureOut     *FactureOut              `json:"factureOut,omitempty"`     // Ссылка на выданный счет-фактуру, с которым связан этот платеж
 	Attributes     Slice[Attribute]         `json:"attributes,omitempty"`     // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -224,7 +227,7 @@ func (cashOut CashOut) GetState() State {
 }
 
 // GetSum возвращает Сумму Расходного ордера в установленной валюте.
-func (cashOut CashOut) GetSum() float64 {
+func (cashOut CashOut) GetSum() Decimal {
 	return Deref(cashOut.Sum)
 }
 
@@ -239,7 +242,7 @@ func (cashOut CashOut) GetUpdated() time.Time {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (cashOut CashOut) GetVatSum() float64 {
+func (cashOut CashOut) GetVatSum() Decimal {
 	return Deref(cashOut.VatSum)
 }
 
@@ -421,7 +424,7 @@ func (cashOut *CashOut) SetState(state *State) *CashOut {
 }
 
 // SetSum устанавливает Сумму Расходного ордера в установленной валюте.
-func (cashOut *CashOut) SetSum(sum float64) *CashOut {
+func (cashOut *CashOut) SetSum(sum Decimal) *CashOut {
 	cashOut.Sum = &sum
 	return cashOut
 }
@@ -485,6 +488,11 @@ type CashOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CashOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех расходных ордеров постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CashOut, error]
+
 	// Create выполняет запрос на создание расходного ордера.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -0,0 +1,409 @@
+// Package cassette содержит транспорт для записи и воспроизведения HTTP-запросов клиента МойСклад в тестах.
+//
+// В режиме записи запросы выполняются к API, а пары запрос/ответ сохраняются в файл кассеты.
+// Токены, пароли и персональные данные заменяются на «[REDACTED]» до сохранения.
+// В режиме воспроизведения ответы возвращаются из кассеты без обращения к сети;
+// запрос сопоставляется с записью по методу, пути, параметрам и телу.
+//
+// # Пример:
+//
+//	func TestShipments(t *testing.T) {
+//		// MOYSKLAD_RECORD=1 go test ./... – записать кассету заново
+//		client := moysklad.New(moysklad.Config{
+//			Token:      os.Getenv("MOYSKLAD_TOKEN"),
+//			HTTPClient: cassette.ForTest(t, "shipments"),
+//		})
+//
+//		demands, _, err := client.Entity().Demand().GetList(context.Background())
+//		...
+//	}
+package cassette
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrInteractionNotFound в кассете нет записи, соответствующей запросу.
+var ErrInteractionNotFound = errors.New("cassette: interaction not found")
+
+// EnvRecord переменная окружения, при установке которой [ForTest] записывает кассету заново.
+const EnvRecord = "MOYSKLAD_RECORD"
+
+// Mode режим работы [Recorder].
+type Mode int
+
+const (
+	ModeAuto   Mode = iota // Воспроизведение, если файл кассеты существует, иначе запись
+	ModeRecord             // Запись: запросы выполняются к API, кассета перезаписывается
+	ModeReplay             // Воспроизведение: запросы к API не выполняются
+)
+
+// Cassette содержимое файла кассеты.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction записанная пара запрос/ответ.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request записанный запрос.
+type Request struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"` // Параметры запроса в каноническом порядке
+	Header http.Header     `json:"header,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Response записанный ответ.
+type Response struct {
+	StatusCode int             `json:"statusCode"`
+	Header     http.Header     `json:"header,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`       // Тело ответа в формате JSON
+	BodyBase64 []byte          `json:"bodyBase64,omitempty"` // Тело ответа в ином формате (например, файл), в файле кассеты – в base64
+}
+
+// Options параметры [Recorder].
+type Options struct {
+	// Режим работы. По умолчанию [ModeAuto].
+	Mode Mode
+
+	// Транспорт, через который выполняются запросы в режиме записи.
+	// По умолчанию [http.DefaultTransport].
+	Transport http.RoundTripper
+
+	// Имена полей JSON, значения которых заменяются на «[REDACTED]» в телах запросов и ответов
+	// (дата и время – на [RedactedTimestamp]), а также в условиях параметра filter.
+	// Строка контекстного поиска (параметр search) заменяется всегда.
+	//
+	// Если не указаны, используются [DefaultScrubFields]. Поля access_token и password заменяются всегда.
+	ScrubFields []string
+
+	// Дополнительная обработка записи перед сохранением и перед сопоставлением запроса
+	// (например, замена идентификаторов учётной записи).
+	Scrub func(interaction *Interaction)
+}
+
+// Recorder транспорт [http.RoundTripper], записывающий и воспроизводящий запросы.
+//
+// Безопасен для использования из нескольких горутин.
+type Recorder struct {
+	path     string
+	mode     Mode
+	options  Options
+	scrubber *scrubber
+	cassette *Cassette
+	used     []bool // Признаки воспроизведённых записей
+	mu       sync.Mutex
+}
+
+// New возвращает [Recorder] для файла кассеты path.
+//
+// В режиме воспроизведения файл читается сразу; в режиме записи сохраняется методом [Recorder.Stop].
+func New(path string, options Options) (*Recorder, error) {
+	recorder := &Recorder{
+		path:     path,
+		mode:     options.Mode,
+		options:  options,
+		scrubber: newScrubber(options.ScrubFields),
+		cassette: &Cassette{},
+	}
+
+	if recorder.options.Transport == nil {
+		recorder.options.Transport = http.DefaultTransport
+	}
+
+	if recorder.mode == ModeAuto {
+		recorder.mode = ModeRecord
+		if _, err := os.Stat(path); err == nil {
+			recorder.mode = ModeReplay
+		}
+	}
+
+	if recorder.mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = json.Unmarshal(data, recorder.cassette); err != nil {
+			return nil, fmt.Errorf("cassette: %s: %w", path, err)
+		}
+
+		recorder.used = make([]bool, len(recorder.cassette.Interactions))
+	}
+
+	return recorder, nil
+}
+
+// TB методы [testing.TB], которые использует [ForTest]. Пакет cassette не импортирует пакет testing.
+type TB interface {
+	Helper()
+	Cleanup(func())
+	Fatalf(format string, args ...any)
+}
+
+// ForTest возвращает [http.Client], записывающий и воспроизводящий запросы теста t
+// с помощью кассеты testdata/cassettes/<name>.json.
+//
+// Кассета записывается, если файл отсутствует или установлена переменная окружения [EnvRecord],
+// и сохраняется по завершении теста.
+func ForTest(t TB, name string, options ...Options) *http.Client {
+	t.Helper()
+
+	var opts Options
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	if os.Getenv(EnvRecord) != "" {
+		opts.Mode = ModeRecord
+	}
+
+	recorder, err := New(filepath.Join("testdata", "cassettes", name+".json"), opts)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := recorder.Stop(); err != nil {
+			t.Fatalf("%v", err)
+		}
+	})
+
+	return recorder.Client()
+}
+
+// Mode возвращает режим работы (запись или воспроизведение).
+func (recorder *Recorder) Mode() Mode {
+	return recorder.mode
+}
+
+// Client возвращает [http.Client], использующий транспорт recorder.
+func (recorder *Recorder) Client() *http.Client {
+	return &http.Client{Transport: recorder}
+}
+
+// Stop сохраняет кассету в режиме записи.
+func (recorder *Recorder) Stop() error {
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(recorder.cassette); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(recorder.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(recorder.path, buf.Bytes(), 0o644)
+}
+
+// RoundTrip реализует интерфейс [http.RoundTripper].
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		Request: Request{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  req.URL.Query().Encode(),
+			Header: req.Header.Clone(),
+			Body:   body,
+		},
+	}
+
+	if recorder.mode == ModeReplay {
+		recorder.scrub(interaction)
+		return recorder.replay(req, interaction)
+	}
+
+	return recorder.record(req, interaction)
+}
+
+// record выполняет запрос и сохраняет пару запрос/ответ.
+func (recorder *Recorder) record(req *http.Request, interaction *Interaction) (*http.Response, error) {
+	resp, err := recorder.options.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction.Response = Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+	}
+
+	if json.Valid(body) {
+		interaction.Response.Body = compactJSON(body)
+	} else {
+		interaction.Response.BodyBase64 = body
+	}
+
+	// вызывающая сторона получает исходный ответ: замена секретов в ответе (например, токена,
+	// полученного по логину и паролю) нарушила бы последующие запросы записи
+	original := interaction.Response
+	original.Header = original.Header.Clone()
+
+	recorder.scrub(interaction)
+
+	recorder.mu.Lock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, interaction)
+	recorder.mu.Unlock()
+
+	return original.httpResponse(req), nil
+}
+
+// replay возвращает записанный ответ на запрос.
+//
+// Одинаковые запросы получают ответы в порядке записи; после исчерпания записей повторяется последний ответ.
+func (recorder *Recorder) replay(req *http.Request, interaction *Interaction) (*http.Response, error) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	last := -1
+	for i, recorded := range recorder.cassette.Interactions {
+		if !recorded.Request.matches(&interaction.Request) {
+			continue
+		}
+
+		if !recorder.used[i] {
+			recorder.used[i] = true
+			return recorded.Response.httpResponse(req), nil
+		}
+
+		last = i
+	}
+
+	if last >= 0 {
+		return recorder.cassette.Interactions[last].Response.httpResponse(req), nil
+	}
+
+	target := req.URL.Path
+	if interaction.Request.Query != "" {
+		target += "?" + interaction.Request.Query
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, target)
+}
+
+func (recorder *Recorder) scrub(interaction *Interaction) {
+	recorder.scrubber.interaction(interaction)
+
+	if recorder.options.Scrub != nil {
+		recorder.options.Scrub(interaction)
+	}
+}
+
+// matches возвращает «true», если запросы совпадают по методу, пути, параметрам и телу.
+func (request *Request) matches(other *Request) bool {
+	return request.Method == other.Method &&
+		request.Path == other.Path &&
+		request.Query == other.Query &&
+		bytes.Equal(request.Body, other.Body)
+}
+
+// httpResponse возвращает ответ на запрос req.
+func (response *Response) httpResponse(req *http.Request) *http.Response {
+	body := []byte(response.Body)
+	if response.BodyBase64 != nil {
+		body = response.BodyBase64
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// readRequestBody читает тело запроса и восстанавливает его для последующей отправки.
+func readRequestBody(req *http.Request) (json.RawMessage, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return compactJSON(data), nil
+}
+
+// readResponseBody читает и распаковывает тело ответа.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+
+	var reader io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+
+		reader = gzipReader
+		resp.Header.Del("Content-Encoding")
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Header.Del("Content-Length")
+	return data, nil
+}
+
+// compactJSON возвращает тело в компактном виде. Тела не в формате JSON (например, файлы)
+// сохраняются в виде строки JSON.
+func compactJSON(data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err == nil {
+		return buf.Bytes()
+	}
+
+	encoded, _ := json.Marshal(string(data))
+	return encoded
+}
@@ -0,0 +1,108 @@
+package cassette_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad/cassette"
+)
+
+func TestRecorderBinaryBody(t *testing.T) {
+	binary := []byte{0x89, 'P', 'N', 'G', 0x00, 0xff, 0xfe, 0x80}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = w.Write(binary)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "binary.json")
+
+	recorder, err := cassette.New(path, cassette.Options{Mode: cassette.ModeRecord})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := get(t, recorder.Client(), server.URL+"/download"); !bytes.Equal(got, binary) {
+		t.Errorf("recorded body = %x, want %x", got, binary)
+	}
+	if err = recorder.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Close()
+
+	replayer, err := cassette.New(path, cassette.Options{Mode: cassette.ModeReplay})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := get(t, replayer.Client(), server.URL+"/download"); !bytes.Equal(got, binary) {
+		t.Errorf("replayed body = %x, want %x", got, binary)
+	}
+}
+
+// fakeTB реализует [cassette.TB] без пакета testing.
+type fakeTB struct {
+	cleanups []func()
+	failures []string
+}
+
+func (tb *fakeTB) Helper()                           {}
+func (tb *fakeTB) Cleanup(fn func())                 { tb.cleanups = append(tb.cleanups, fn) }
+func (tb *fakeTB) Fatalf(format string, args ...any) { tb.failures = append(tb.failures, format) }
+
+func TestForTest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"rows":[]}`))
+	}))
+	defer server.Close()
+
+	// ForTest сохраняет кассету относительно текущего каталога
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	tb := new(fakeTB)
+	client := cassette.ForTest(tb, "list", cassette.Options{Mode: cassette.ModeRecord})
+	get(t, client, server.URL+"/entity/product")
+
+	for _, cleanup := range tb.cleanups {
+		cleanup()
+	}
+	if len(tb.failures) > 0 {
+		t.Fatalf("ForTest failed: %v", tb.failures)
+	}
+
+	data, err := os.ReadFile(filepath.Join("testdata", "cassettes", "list.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`"rows"`)) {
+		t.Errorf("cassette = %s, want recorded response body", data)
+	}
+}
+
+func get(t *testing.T, client *http.Client, url string) []byte {
+	t.Helper()
+
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
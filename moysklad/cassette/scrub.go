@@ -0,0 +1,169 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Redacted значение, на которое заменяются секреты и персональные данные.
+const Redacted = "[REDACTED]"
+
+// RedactedTimestamp значение, на которое заменяются дата и время (например, дата рождения),
+// чтобы запись оставалась пригодной для декодирования в Timestamp.
+const RedactedTimestamp = "2000-01-01 00:00:00.000"
+
+// DefaultScrubFields поля JSON с персональными данными, заменяемые по умолчанию:
+// контактные данные, реквизиты и ФИО контрагентов, контактных лиц и сотрудников.
+var DefaultScrubFields = []string{
+	"email", "phone", "fax",
+	"inn", "kpp", "ogrn", "ogrnip", "okpo",
+	"legalTitle", "legalAddress", "actualAddress", "legalAddressFull", "actualAddressFull",
+	"legalFirstName", "legalMiddleName", "legalLastName",
+	"firstName", "middleName", "lastName", "fullName", "shortFio",
+	"birthDate", "discountCardNumber", "uid",
+}
+
+// secretFields поля JSON, заменяемые всегда.
+var secretFields = []string{"access_token", "password"}
+
+// secretHeaders заголовки, удаляемые из записей.
+var secretHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+type scrubber struct {
+	fields []string
+}
+
+func newScrubber(fields []string) *scrubber {
+	if fields == nil {
+		fields = DefaultScrubFields
+	}
+	return &scrubber{fields: append(slices.Clone(secretFields), fields...)}
+}
+
+// interaction заменяет секреты и персональные данные в записи.
+func (scrubber *scrubber) interaction(interaction *Interaction) {
+	for _, header := range secretHeaders {
+		interaction.Request.Header.Del(header)
+		interaction.Response.Header.Del(header)
+	}
+
+	interaction.Request.Query = scrubber.query(interaction.Request.Query)
+	interaction.Request.Body = scrubber.body(interaction.Request.Body)
+	interaction.Response.Body = scrubber.body(interaction.Response.Body)
+}
+
+// body заменяет значения полей в теле JSON.
+func (scrubber *scrubber) body(body json.RawMessage) json.RawMessage {
+	if len(body) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(scrubber.value(value, false)); err != nil {
+		return body
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+// value обходит значение value. Если redact установлен, заменяются все строковые значения,
+// кроме метаданных, чтобы запись оставалась пригодной для декодирования.
+func (scrubber *scrubber) value(value any, redact bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if key == "meta" {
+				continue
+			}
+			v[key] = scrubber.value(field, redact || slices.Contains(scrubber.fields, key))
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = scrubber.value(item, redact)
+		}
+		return v
+	case string:
+		if !redact {
+			return v
+		}
+		if isTimestamp(v) {
+			return RedactedTimestamp
+		}
+		return Redacted
+	default:
+		return v
+	}
+}
+
+// query заменяет персональные данные в параметрах запроса: строку контекстного поиска
+// и значения условий фильтра по заменяемым полям.
+func (scrubber *scrubber) query(query string) string {
+	values, err := url.ParseQuery(query)
+	if err != nil || len(values) == 0 {
+		return query
+	}
+
+	for key, list := range values {
+		for i, value := range list {
+			switch key {
+			case "search":
+				list[i] = Redacted
+			case "filter":
+				list[i] = scrubber.filter(value)
+			}
+		}
+	}
+
+	return values.Encode()
+}
+
+// filter заменяет значения условий фильтра вида key=value;key2!=value2 по заменяемым полям.
+func (scrubber *scrubber) filter(filter string) string {
+	conditions := strings.Split(filter, ";")
+	for i, condition := range conditions {
+		end := strings.IndexAny(condition, "=!<>~")
+		if end <= 0 || !slices.Contains(scrubber.fields, condition[:end]) {
+			continue
+		}
+
+		// оператор фильтра состоит из символов =!<>~
+		valueStart := end
+		for valueStart < len(condition) && strings.ContainsRune("=!<>~", rune(condition[valueStart])) {
+			valueStart++
+		}
+
+		value := condition[valueStart:]
+		if isTimestamp(value) {
+			value = RedactedTimestamp
+		} else if value != "" {
+			value = Redacted
+		}
+		conditions[i] = condition[:valueStart] + value
+	}
+	return strings.Join(conditions, ";")
+}
+
+// isTimestamp возвращает «true», если строка s имеет формат даты и времени API (2006-01-02 15:04:05).
+func isTimestamp(s string) bool {
+	if len(s) < len("2006-01-02 15:04:05") {
+		return false
+	}
+	_, err := time.Parse("2006-01-02 15:04:05", s[:len("2006-01-02 15:04:05")])
+	return err == nil
+}
@@ -0,0 +1,250 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen запрос отклонён без обращения к API, так как [CircuitBreaker] разомкнут.
+//
+// Ошибка возвращается в виде [*CircuitOpenError] и проверяется с помощью [errors.Is].
+var ErrCircuitOpen = errors.New("moysklad: circuit breaker is open")
+
+// CircuitOpenError ошибка отклонения запроса разомкнутым [CircuitBreaker].
+type CircuitOpenError struct {
+	State      CircuitState  // Состояние автомата в момент отклонения запроса
+	RetryAfter time.Duration // Время до перехода автомата в полуоткрытое состояние
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s (state: %s, retry after: %s)", ErrCircuitOpen, e.State, e.RetryAfter.Round(time.Millisecond))
+}
+
+// Is реализует сравнение с [ErrCircuitOpen] для [errors.Is].
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitState состояние [CircuitBreaker].
+type CircuitState int
+
+const (
+	CircuitClosed   CircuitState = iota // Замкнут: запросы выполняются
+	CircuitOpen                         // Разомкнут: запросы отклоняются
+	CircuitHalfOpen                     // Полуоткрыт: выполняются пробные запросы
+)
+
+func (state CircuitState) String() string {
+	switch state {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return fmt.Sprintf("CircuitState(%d)", int(state))
+	}
+}
+
+const (
+	defaultCircuitFailureThreshold = 5                // Количество ошибок подряд для размыкания по умолчанию
+	defaultCircuitOpenTimeout      = 30 * time.Second // Время в разомкнутом состоянии по умолчанию
+)
+
+// CircuitBreakerConfig конфигурация [CircuitBreaker].
+type CircuitBreakerConfig struct {
+	// Количество ошибок подряд (ответы 5xx, тайм-ауты и сетевые ошибки), после которого автомат размыкается.
+	// По умолчанию 5.
+	FailureThreshold int
+
+	// Время, в течение которого запросы отклоняются, перед переходом в полуоткрытое состояние.
+	// По умолчанию 30 секунд.
+	OpenTimeout time.Duration
+
+	// Количество пробных запросов в полуоткрытом состоянии. Автомат замыкается после того,
+	// как все пробные запросы выполнены успешно, и размыкается снова при первой ошибке. По умолчанию 1.
+	HalfOpenRequests int
+
+	// Функция, вызываемая при каждом изменении состояния (например, для проверок работоспособности).
+	// Вызывается синхронно вне блокировки автомата.
+	OnStateChange func(from, to CircuitState)
+}
+
+// CircuitBreaker автоматический выключатель запросов к API.
+//
+// Во время недоступности МойСклад запросы отклоняются сразу, без ожидания ограничителя запросов,
+// с ошибкой [*CircuitOpenError]. Ошибки контекста вызывающей стороны и ответы 4xx не учитываются.
+//
+// Один экземпляр можно передать в конфигурацию нескольких клиентов.
+//
+// # Пример:
+//
+//	breaker := moysklad.NewCircuitBreaker(moysklad.CircuitBreakerConfig{
+//		OnStateChange: func(from, to moysklad.CircuitState) {
+//			health.SetDegraded(to != moysklad.CircuitClosed)
+//		},
+//	})
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:          "MS_TOKEN_HERE",
+//		CircuitBreaker: breaker,
+//	})
+type CircuitBreaker struct {
+	config    CircuitBreakerConfig
+	state     CircuitState
+	failures  int       // Количество ошибок подряд в замкнутом состоянии
+	probes    int       // Количество выполняемых пробных запросов
+	successes int       // Количество успешных пробных запросов
+	openedAt  time.Time // Момент размыкания
+	mu        sync.Mutex
+}
+
+// NewCircuitBreaker возвращает новый [CircuitBreaker] в замкнутом состоянии.
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = defaultCircuitFailureThreshold
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = defaultCircuitOpenTimeout
+	}
+	if config.HalfOpenRequests <= 0 {
+		config.HalfOpenRequests = 1
+	}
+	return &CircuitBreaker{config: config}
+}
+
+// State возвращает текущее состояние автомата.
+func (breaker *CircuitBreaker) State() CircuitState {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+
+	if breaker.state == CircuitOpen && time.Since(breaker.openedAt) >= breaker.config.OpenTimeout {
+		return CircuitHalfOpen
+	}
+	return breaker.state
+}
+
+// Reset переводит автомат в замкнутое состояние.
+func (breaker *CircuitBreaker) Reset() {
+	breaker.mu.Lock()
+	from := breaker.state
+	breaker.setState(CircuitClosed)
+	breaker.mu.Unlock()
+
+	breaker.notify(from, CircuitClosed)
+}
+
+// allow проверяет, можно ли выполнить запрос. При успехе возвращает функцию,
+// которую необходимо вызвать с результатом запроса (см. circuitOutcome).
+func (breaker *CircuitBreaker) allow() (func(outcome int), error) {
+	breaker.mu.Lock()
+
+	from := breaker.state
+	if breaker.state == CircuitOpen {
+		if wait := breaker.config.OpenTimeout - time.Since(breaker.openedAt); wait > 0 {
+			breaker.mu.Unlock()
+			return nil, &CircuitOpenError{State: CircuitOpen, RetryAfter: wait}
+		}
+		breaker.setState(CircuitHalfOpen)
+	}
+
+	to := breaker.state
+
+	probe := to == CircuitHalfOpen
+	if probe {
+		if breaker.probes+breaker.successes >= breaker.config.HalfOpenRequests {
+			breaker.mu.Unlock()
+			breaker.notify(from, to)
+			return nil, &CircuitOpenError{State: to}
+		}
+		breaker.probes++
+	}
+
+	breaker.mu.Unlock()
+	breaker.notify(from, to)
+
+	return func(outcome int) {
+		breaker.record(probe, outcome)
+	}, nil
+}
+
+// circuitOutcome возвращает результат запроса для автомата:
+// 1 – ошибка, -1 – успех, 0 – запрос не учитывается (отменён вызывающей стороной).
+//
+// Истечение срока контекста ([context.DeadlineExceeded]) считается тайм-аутом и учитывается как ошибка.
+func circuitOutcome(ctx context.Context, resp *resty.Response, err error) int {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(ctx.Err(), context.Canceled) {
+			return 0
+		}
+		return 1
+	}
+
+	if resp != nil && resp.StatusCode() >= http.StatusInternalServerError {
+		return 1
+	}
+	return -1
+}
+
+// record учитывает результат запроса.
+func (breaker *CircuitBreaker) record(probe bool, outcome int) {
+	breaker.mu.Lock()
+
+	from := breaker.state
+
+	if probe {
+		breaker.probes--
+	}
+
+	switch {
+	case breaker.state == CircuitHalfOpen && probe:
+		switch outcome {
+		case 1:
+			breaker.setState(CircuitOpen)
+		case -1:
+			breaker.successes++
+			if breaker.successes >= breaker.config.HalfOpenRequests {
+				breaker.setState(CircuitClosed)
+			}
+		}
+	case breaker.state == CircuitClosed:
+		switch outcome {
+		case 1:
+			breaker.failures++
+			if breaker.failures >= breaker.config.FailureThreshold {
+				breaker.setState(CircuitOpen)
+			}
+		case -1:
+			breaker.failures = 0
+		}
+	}
+
+	to := breaker.state
+	breaker.mu.Unlock()
+
+	breaker.notify(from, to)
+}
+
+// setState устанавливает состояние state и сбрасывает счётчики. Вызывается под блокировкой.
+func (breaker *CircuitBreaker) setState(state CircuitState) {
+	breaker.state = state
+	breaker.failures = 0
+	breaker.successes = 0
+
+	if state == CircuitOpen {
+		breaker.openedAt = time.Now()
+	}
+}
+
+// notify вызывает OnStateChange, если состояние изменилось.
+func (breaker *CircuitBreaker) notify(from, to CircuitState) {
+	if from != to && breaker.config.OnStateChange != nil {
+		breaker.config.OnStateChange(from, to)
+	}
+}
@@ -267,6 +267,36 @@ type ReportService interface {
 	ByOperations() ReportByOperationsService
 }
 
+// API описывает методы клиента для получения сервисов.
+// Реализуется [Client] и моком mocks.Client, что позволяет подменять клиент в тестах бизнес-логики.
+type API interface {
+	// AccountSettings возвращает сервис для работы с настройками учётных записей.
+	AccountSettings() AccountService
+
+	// Async возвращает сервис для работы с асинхронными задачами.
+	Async() AsyncService
+
+	// Audit возвращает сервис для работы с аудитом.
+	Audit() AuditService
+
+	// Context возвращает сервис для работы с контекстом.
+	Context() ContextService
+
+	// Entity возвращает сервис для работы с сущностями и документами.
+	Entity() EntityService
+
+	// Report возвращает сервис для работы с отчётами.
+	Report() ReportService
+
+	// Security возвращает сервис для получения нового токена.
+	Security() SecurityTokenService
+
+	// Notification возвращает сервис для работы с уведомлениями.
+	Notification() NotificationService
+}
+
+var _ API = (*Client)(nil)
+
 // AccountSettings возвращает сервис для работы с настройками учётных записей.
 func (client *Client) AccountSettings() AccountService {
 	return &accountService{client}
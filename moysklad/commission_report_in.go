@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-poluchennyj-otchet-komissionera
 type CommissionReportIn struct {
-	VatSum                        *float64                                     `json:"vatSum,omitempty"`                        // Сумма НДС
+	VatSum                        *Decimal                                     `json:"vatSum,omitempty"`                        // Сумма НДС
 	Organization                  *Organization                                `json:"organization,omitempty"`                  // Метаданные юрлица
 	AgentAccount                  *AgentAccount                                `json:"agentAccount,omitempty"`                  // Метаданные счета контрагента
 	Agent                         *Counterparty                                `json:"agent,omitempty"`                         // Метаданные контрагента
@@ -25,7 +26,7 @@ type CommissionReportIn struct {
 	CommissionOverhead            *CommissionOverhead                          `json:"commissionOverhead,omitempty"`            // Прочие расходы. Если Позиции отчёта комиссионера не заданы, то расходы нельзя задать
 	CommissionPeriodEnd           *Timestamp                                   `json:"commissionPeriodEnd,omitempty"`           // Конец периода
 	CommissionPeriodStart         *Timestamp                                   `json:"commissionPeriodStart,omitempty"`         // Начало периода
-	CommitentSum                  *float64                                     `json:"commitentSum,omitempty"`                  // Сумма комитента в установленной валюте
+	CommitentSum                  *Decimal                                     `json:"commitentSum,omitempty"`                  // Сумма комитента в установленной валюте
 	Contract                      *Contract                                    `json:"contract,omitempty"`                      // Метаданные договора
 	Created                       *Timestamp                                   `json:"created,omitempty"`                       // Дата создания
 	Deleted                       *Timestamp                                   `json:"deleted,omitempty"`                       // Момент последнего удаления Полученного отчёта комиссионера
@@ -40,7 +41,7 @@ type CommissionReportIn struct {
 	Applicable                    *bool                                        `json:"applicable,omitempty"`                    // Отметка о проведении
 	OrganizationAccount           *AgentAccount                                `json:"organizationAccount,omitempty"`           // Метаданные счета юрлица
 	Owner                         *Employee                                    `json:"owner,omitempty"`                         // Метаданные владельца (Сотрудника)
-	PayedSum                      *float64                                     `json:"payedSum,omitempty"`                      // Оплаченная сумма
+	PayedSum                      *Decimal                                     `json:"payedSum,omitempty"`                      // Оплаченная сумма
 	Positions                     *MetaArray[CommissionReportInPosition]       `json:"positions,omitempty"`                     // Метаданные позиций реализовано комиссионером Полученного отчёта комиссионера
 	Printed                       *bool                                        `json:"printed,omitempty"`                       // Напечатан ли документ
 	Project                       *NullValue[Project]                          `json:"project,omitempty"`                       // Метаданные проекта
@@ -52,13 +53,15 @@ type CommissionReportIn struct {
 	SalesChannel                  *NullValue[SalesChannel]                     `json:"salesChannel,omitempty"`                  // Метаданные канала продаж
 	Shared                        *bool                                        `json:"shared,omitempty"`                        // Общий доступ
 	State                         *NullValue[State]                            `json:"state,omitempty"`                         // Метаданные статуса Полученного отчёта комиссионера
-	Sum                           *float64                                     `json:"sum,omitempty"`                           // Сумма Полученного отчёта комиссионера в копейках
+	Sum                           *Decimal                                     `json:"sum,omitempty"`                           // Сумма Полученного отчёта комиссионера в копейках
 	SyncID                        *string                                      `json:"syncId,omitempty"`                        // ID синхронизации
 	Updated                       *Timestamp                                   `json:"updated,omitempty"`                       // Момент последнего обновления Полученного отчёта комиссионера
 	VatEnabled                    *bool                                        `json:"vatEnabled,omitempty"`                    // Учитывается ли НДС
 	VatIncluded                   *bool                                        `json:"vatIncluded,omitempty"`                   // Включен ли НДС в цену
 	RewardType                    RewardType                                   `json:"rewardType,omitempty"`                    // Тип вознаграждения
 	Attributes                    Slice[Attribute]                             `json:"attributes,omitempty"`                    // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -87,7 +90,7 @@ func (commissionReportIn CommissionReportIn) AsOperationIn() *Operation {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (commissionReportIn CommissionReportIn) GetVatSum() float64 {
+func (commissionReportIn CommissionReportIn) GetVatSum() Decimal {
 	return Deref(commissionReportIn.VatSum)
 }
 
@@ -122,7 +125,7 @@ func (commissionReportIn CommissionReportIn) GetCommissionOverhead() CommissionO
 }
 
 // GetCommissionOverheadSum возвращает Сумму в копейках Прочих расходов.
-func (commissionReportIn CommissionReportIn) GetCommissionOverheadSum() float64 {
+func (commissionReportIn CommissionReportIn) GetCommissionOverheadSum() Decimal {
 	return Deref(commissionReportIn.CommissionOverhead).GetSum()
 }
 
@@ -137,7 +140,7 @@ func (commissionReportIn CommissionReportIn) GetCommissionPeriodStart() time.Tim
 }
 
 // GetCommitentSum возвращает Сумму комитента в установленной валюте.
-func (commissionReportIn CommissionReportIn) GetCommitentSum() float64 {
+func (commissionReportIn CommissionReportIn) GetCommitentSum() Decimal {
 	return Deref(commissionReportIn.CommitentSum)
 }
 
@@ -212,7 +215,7 @@ func (commissionReportIn CommissionReportIn) GetOwner() Employee {
 }
 
 // GetPayedSum возвращает Оплаченную сумму.
-func (commissionReportIn CommissionReportIn) GetPayedSum() float64 {
+func (commissionReportIn CommissionReportIn) GetPayedSum() Decimal {
 	return Deref(commissionReportIn.PayedSum)
 }
 
@@ -272,7 +275,7 @@ func (commissionReportIn CommissionReportIn) GetState() State {
 }
 
 // GetSum возвращает Сумму Полученного отчёта комиссионера в копейках.
-func (commissionReportIn CommissionReportIn) GetSum() float64 {
+func (commissionReportIn CommissionReportIn) GetSum() Decimal {
 	return Deref(commissionReportIn.Sum)
 }
 
@@ -355,7 +358,7 @@ func (commissionReportIn *CommissionReportIn) SetCommissionOverhead(commissionOv
 // SetCommissionOverheadSum устанавливает сумму в копейках Прочих расходов.
 //
 // Если Позиции отчёта комиссионера не заданы, то расходы нельзя задать.
-func (commissionReportIn *CommissionReportIn) SetCommissionOverheadSum(sum float64) *CommissionReportIn {
+func (commissionReportIn *CommissionReportIn) SetCommissionOverheadSum(sum Decimal) *CommissionReportIn {
 	commissionReportIn.CommissionOverhead = &CommissionOverhead{&sum}
 	return commissionReportIn
 }
@@ -573,16 +576,16 @@ func (commissionReportIn *CommissionReportIn) Delete(ctx context.Context, client
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-poluchennyj-otchet-komissionera-poluchennye-otchety-komissionera-prochie-rashody
 type CommissionOverhead struct {
-	Sum *float64 `json:"sum,omitempty"` // Сумма в копейках
+	Sum *Decimal `json:"sum,omitempty"` // Сумма в копейках
 }
 
 // GetSum возвращает сумму в копейках.
-func (commissionOverhead CommissionOverhead) GetSum() float64 {
+func (commissionOverhead CommissionOverhead) GetSum() Decimal {
 	return Deref(commissionOverhead.Sum)
 }
 
 // SetSum устанавливает сумму в копейках.
-func (commissionOverhead *CommissionOverhead) SetSum(sum float64) *CommissionOverhead {
+func (commissionOverhead *CommissionOverhead) SetSum(sum Decimal) *CommissionOverhead {
 	commissionOverhead.Sum = &sum
 	return commissionOverhead
 }
@@ -604,11 +607,13 @@ type CommissionReportInPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции.
-	Reward     *float64            `json:"reward,omitempty"`     // Вознаграждение
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции.
+	Reward     *Decimal            `json:"reward,omitempty"`     // Вознаграждение
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -632,17 +637,17 @@ func (commissionReportInPosition CommissionReportInPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (commissionReportInPosition CommissionReportInPosition) GetPrice() float64 {
+func (commissionReportInPosition CommissionReportInPosition) GetPrice() Decimal {
 	return Deref(commissionReportInPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (commissionReportInPosition CommissionReportInPosition) GetQuantity() float64 {
+func (commissionReportInPosition CommissionReportInPosition) GetQuantity() Decimal {
 	return Deref(commissionReportInPosition.Quantity)
 }
 
 // GetReward возвращает Вознаграждение.
-func (commissionReportInPosition CommissionReportInPosition) GetReward() float64 {
+func (commissionReportInPosition CommissionReportInPosition) GetReward() Decimal {
 	return Deref(commissionReportInPosition.Reward)
 }
 
@@ -675,19 +680,19 @@ func (commissionReportInPosition *CommissionReportInPosition) SetPack(pack *Pack
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (commissionReportInPosition *CommissionReportInPosition) SetPrice(price float64) *CommissionReportInPosition {
+func (commissionReportInPosition *CommissionReportInPosition) SetPrice(price Decimal) *CommissionReportInPosition {
 	commissionReportInPosition.Price = &price
 	return commissionReportInPosition
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (commissionReportInPosition *CommissionReportInPosition) SetQuantity(quantity float64) *CommissionReportInPosition {
+func (commissionReportInPosition *CommissionReportInPosition) SetQuantity(quantity Decimal) *CommissionReportInPosition {
 	commissionReportInPosition.Quantity = &quantity
 	return commissionReportInPosition
 }
 
 // SetReward устанавливает Вознаграждение.
-func (commissionReportInPosition *CommissionReportInPosition) SetReward(reward float64) *CommissionReportInPosition {
+func (commissionReportInPosition *CommissionReportInPosition) SetReward(reward Decimal) *CommissionReportInPosition {
 	commissionReportInPosition.Reward = &reward
 	return commissionReportInPosition
 }
@@ -725,11 +730,13 @@ type CommissionReportInReturnPosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
-	Reward     *float64            `json:"reward,omitempty"`     // Вознаграждение
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
+	Reward     *Decimal            `json:"reward,omitempty"`     // Вознаграждение
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -748,17 +755,17 @@ func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetID()
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetPrice() float64 {
+func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetPrice() Decimal {
 	return Deref(commissionReportInReturnPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetQuantity() float64 {
+func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetQuantity() Decimal {
 	return Deref(commissionReportInReturnPosition.Quantity)
 }
 
 // GetReward возвращает Вознаграждение.
-func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetReward() float64 {
+func (commissionReportInReturnPosition CommissionReportInReturnPosition) GetReward() Decimal {
 	return Deref(commissionReportInReturnPosition.Reward)
 }
 
@@ -783,19 +790,19 @@ func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetAss
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetPrice(price float64) *CommissionReportInReturnPosition {
+func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetPrice(price Decimal) *CommissionReportInReturnPosition {
 	commissionReportInReturnPosition.Price = &price
 	return commissionReportInReturnPosition
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetQuantity(quantity float64) *CommissionReportInReturnPosition {
+func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetQuantity(quantity Decimal) *CommissionReportInReturnPosition {
 	commissionReportInReturnPosition.Quantity = &quantity
 	return commissionReportInReturnPosition
 }
 
 // SetReward устанавливает Вознаграждение.
-func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetReward(reward float64) *CommissionReportInReturnPosition {
+func (commissionReportInReturnPosition *CommissionReportInReturnPosition) SetReward(reward Decimal) *CommissionReportInReturnPosition {
 	commissionReportInReturnPosition.Reward = &reward
 	return commissionReportInReturnPosition
 }
@@ -834,6 +841,11 @@ type CommissionReportInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CommissionReportIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех полученных отчётов комиссионера постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CommissionReportIn, error]
+
 	// Create выполняет запрос на создание полученного отчёта комиссионера.
 	// Обязательные поля для заполнения:
 	//	- agent (Контрагент)
@@ -890,6 +902,11 @@ type CommissionReportInService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[CommissionReportInPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*CommissionReportInPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -1104,9 +1121,10 @@ type CommissionReportInService interface {
 }
 
 const (
-	EndpointCommissionReportIn                  = EndpointEntity + string(MetaTypeCommissionReportIn)
-	EndpointCommissionReportInReturnPositions   = EndpointCommissionReportIn + "/%s/returntocommissionerpositions"
-	EndpointCommissionReportInReturnPositionsID = EndpointCommissionReportInReturnPositions + "/%s"
+	EndpointCommissionReportIn                      = EndpointEntity + string(MetaTypeCommissionReportIn)
+	EndpointCommissionReportInReturnPositions       = EndpointCommissionReportIn + "/%s/returntocommissionerpositions"
+	EndpointCommissionReportInReturnPositionsID     = EndpointCommissionReportInReturnPositions + "/%s"
+	EndpointCommissionReportInReturnPositionsDelete = EndpointCommissionReportInReturnPositions + EndpointDelete
 )
 
 type commissionReportInService struct {
@@ -1153,12 +1171,12 @@ func (service *commissionReportInService) UpdateReturnPosition(ctx context.Conte
 }
 
 func (service *commissionReportInService) DeleteReturnPosition(ctx context.Context, id, positionID string) (bool, *resty.Response, error) {
-	path := fmt.Sprintf(EndpointPositionsID, id, positionID)
+	path := fmt.Sprintf(EndpointCommissionReportInReturnPositionsID, id, positionID)
 	return NewRequestBuilder[any](service.client, path).Delete(ctx)
 }
 
 func (service *commissionReportInService) DeleteReturnPositionMany(ctx context.Context, id string, entities ...*CommissionReportInReturnPosition) (*DeleteManyResponse, *resty.Response, error) {
-	path := fmt.Sprintf(EndpointPositionsDelete, id)
+	path := fmt.Sprintf(EndpointCommissionReportInReturnPositionsDelete, id)
 	return NewRequestBuilder[DeleteManyResponse](service.client, path).Post(ctx, entities)
 }
 
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,11 +20,11 @@ type CommissionReportOut struct {
 	OrganizationAccount   *AgentAccount                           `json:"organizationAccount,omitempty"`   // Метаданные счета юрлица
 	AgentAccount          *AgentAccount                           `json:"agentAccount,omitempty"`          // Метаданные счета контрагента
 	Organization          *Organization                           `json:"organization,omitempty"`          // Метаданные юрлица
-	VatSum                *float64                                `json:"vatSum,omitempty"`                // Сумма НДС
+	VatSum                *Decimal                                `json:"vatSum,omitempty"`                // Сумма НДС
 	Code                  *string                                 `json:"code,omitempty"`                  // Код Выданного отчета комиссионера
 	CommissionPeriodEnd   *Timestamp                              `json:"commissionPeriodEnd,omitempty"`   // Конец периода
 	Agent                 *Counterparty                           `json:"agent,omitempty"`                 // Метаданные контрагента
-	CommitentSum          *float64                                `json:"commitentSum,omitempty"`          // Сумма коммитента в установленной валюте
+	CommitentSum          *Decimal                                `json:"commitentSum,omitempty"`          // Сумма коммитента в установленной валюте
 	Contract              *Contract                               `json:"contract,omitempty"`              // Метаданные договора
 	Created               *Timestamp                              `json:"created,omitempty"`               // Дата создания
 	Deleted               *Timestamp                              `json:"deleted,omitempty"`               // Момент последнего удаления Выданного отчета комиссионера
@@ -38,7 +39,7 @@ type CommissionReportOut struct {
 	AccountID             *string                                 `json:"accountId,omitempty"`             // ID учётной записи
 	CommissionPeriodStart *Timestamp                              `json:"commissionPeriodStart,omitempty"` // Начало периода
 	Owner                 *Employee                               `json:"owner,omitempty"`                 // Метаданные владельца (Сотрудника)
-	PayedSum              *float64                                `json:"payedSum,omitempty"`              // Оплаченная сумма
+	PayedSum              *Decimal                                `json:"payedSum,omitempty"`              // Оплаченная сумма
 	Positions             *MetaArray[CommissionReportOutPosition] `json:"positions,omitempty"`             // Метаданные позиций Выданного отчета
 	Printed               *bool                                   `json:"printed,omitempty"`               // Напечатан ли документ
 	Project               *NullValue[Project]                     `json:"project,omitempty"`               // Метаданные проекта
@@ -49,13 +50,15 @@ type CommissionReportOut struct {
 	SalesChannel          *NullValue[SalesChannel]                `json:"salesChannel,omitempty"`          // Метаданные канала продаж
 	Shared                *bool                                   `json:"shared,omitempty"`                // Общий доступ
 	State                 *NullValue[State]                       `json:"state,omitempty"`                 // Метаданные статуса Выданного отчета комиссионера
-	Sum                   *float64                                `json:"sum,omitempty"`                   // Сумма Выданного отчета комиссионера в копейках
+	Sum                   *Decimal                                `json:"sum,omitempty"`                   // Сумма Выданного отчета комиссионера в копейках
 	SyncID                *string                                 `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                              `json:"updated,omitempty"`               // Момент последнего обновления Выданного отчета комиссионера
 	VatEnabled            *bool                                   `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
 	VatIncluded           *bool                                   `json:"vatIncluded,omitempty"`           // Включен ли НДС в цену
 	RewardType            RewardType                              `json:"rewardType,omitempty"`            // Тип вознаграждения
 	Attributes            Slice[Attribute]                        `json:"attributes,omitempty"`            // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -104,7 +107,7 @@ func (commissionReportOut CommissionReportOut) GetOrganization() Organization {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (commissionReportOut CommissionReportOut) GetVatSum() float64 {
+func (commissionReportOut CommissionReportOut) GetVatSum() Decimal {
 	return Deref(commissionReportOut.VatSum)
 }
 
@@ -124,7 +127,7 @@ func (commissionReportOut CommissionReportOut) GetAgent() Counterparty {
 }
 
 // GetCommitentSum возвращает Сумму комитента в установленной валюте.
-func (commissionReportOut CommissionReportOut) GetCommitentSum() float64 {
+func (commissionReportOut CommissionReportOut) GetCommitentSum() Decimal {
 	return Deref(commissionReportOut.CommitentSum)
 }
 
@@ -199,7 +202,7 @@ func (commissionReportOut CommissionReportOut) GetOwner() Employee {
 }
 
 // GetPayedSum возвращает Оплаченную сумму.
-func (commissionReportOut CommissionReportOut) GetPayedSum() float64 {
+func (commissionReportOut CommissionReportOut) GetPayedSum() Decimal {
 	return Deref(commissionReportOut.PayedSum)
 }
 
@@ -254,7 +257,7 @@ func (commissionReportOut CommissionReportOut) GetState() State {
 }
 
 // GetSum возвращает Сумму Выданного отчёта комиссионера в копейках.
-func (commissionReportOut CommissionReportOut) GetSum() float64 {
+func (commissionReportOut CommissionReportOut) GetSum() Decimal {
 	return Deref(commissionReportOut.Sum)
 }
 
@@ -535,11 +538,13 @@ type CommissionReportOutPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-	Reward     *float64            `json:"reward,omitempty"`     // Вознаграждение
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Reward     *Decimal            `json:"reward,omitempty"`     // Вознаграждение
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -563,17 +568,17 @@ func (commissionReportOutPosition CommissionReportOutPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (commissionReportOutPosition CommissionReportOutPosition) GetPrice() float64 {
+func (commissionReportOutPosition CommissionReportOutPosition) GetPrice() Decimal {
 	return Deref(commissionReportOutPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (commissionReportOutPosition CommissionReportOutPosition) GetQuantity() float64 {
+func (commissionReportOutPosition CommissionReportOutPosition) GetQuantity() Decimal {
 	return Deref(commissionReportOutPosition.Quantity)
 }
 
 // GetReward возвращает Вознаграждение.
-func (commissionReportOutPosition CommissionReportOutPosition) GetReward() float64 {
+func (commissionReportOutPosition CommissionReportOutPosition) GetReward() Decimal {
 	return Deref(commissionReportOutPosition.Reward)
 }
 
@@ -606,19 +611,19 @@ func (commissionReportOutPosition *CommissionReportOutPosition) SetPack(pack *Pa
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (commissionReportOutPosition *CommissionReportOutPosition) SetPrice(price *float64) *CommissionReportOutPosition {
+func (commissionReportOutPosition *CommissionReportOutPosition) SetPrice(price *Decimal) *CommissionReportOutPosition {
 	commissionReportOutPosition.Price = price
 	return commissionReportOutPosition
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (commissionReportOutPosition *CommissionReportOutPosition) SetQuantity(quantity float64) *CommissionReportOutPosition {
+func (commissionReportOutPosition *CommissionReportOutPosition) SetQuantity(quantity Decimal) *CommissionReportOutPosition {
 	commissionReportOutPosition.Quantity = &quantity
 	return commissionReportOutPosition
 }
 
 // SetReward устанавливает Вознаграждение.
-func (commissionReportOutPosition *CommissionReportOutPosition) SetReward(reward *float64) *CommissionReportOutPosition {
+func (commissionReportOutPosition *CommissionReportOutPosition) SetReward(reward *Decimal) *CommissionReportOutPosition {
 	commissionReportOutPosition.Reward = reward
 	return commissionReportOutPosition
 }
@@ -657,6 +662,11 @@ type CommissionReportOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CommissionReportOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех выданных отчётов комиссионера постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CommissionReportOut, error]
+
 	// Create выполняет запрос на создание выданного отчёта комиссионера.
 	// Обязательные поля для заполнения:
 	//	- agent (Контрагент)
@@ -713,6 +723,11 @@ type CommissionReportOutService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[CommissionReportOutPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*CommissionReportOutPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -25,6 +25,8 @@ type CompanySettings struct {
 	DiscountStrategy         DiscountStrategy `json:"discountStrategy,omitempty"`         // Совместное применение скидок
 	AccountCountry           AccountCountry   `json:"accountCountry,omitempty"`           // Передается для информации о том, какая страновая конфигурация активна на аккаунте пользователя
 	PriceTypes               Slice[PriceType] `json:"priceTypes,omitempty"`               // Коллекция всех существующих типов цен
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetMeta возвращает Метаданные Настроек компании.
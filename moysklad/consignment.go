@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -28,6 +29,8 @@ type Consignment struct {
 	Label        *string             `json:"label,omitempty"`        // Метка Серии
 	Updated      *Timestamp          `json:"updated,omitempty"`      // Момент последнего обновления сущности
 	Attributes   Slice[Attribute]    `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -227,6 +230,11 @@ type ConsignmentService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Consignment], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех серий постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Consignment, error]
+
 	// Create выполняет запрос на создание серии.
 	// Обязательные поля для заполнения:
 	//	- label (Метка Серии)
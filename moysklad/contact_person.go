@@ -23,6 +23,8 @@ type ContactPerson struct {
 	Phone        *string       `json:"phone,omitempty"`        // Номер телефона контактного лица
 	Position     *string       `json:"position,omitempty"`     // Должность контактного лица
 	Updated      *Timestamp    `json:"updated,omitempty"`      // Момент последнего обновления
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
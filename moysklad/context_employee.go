@@ -41,6 +41,8 @@ type ContextEmployee struct {
 	Cashiers     MetaArray[Cashier] `json:"cashiers,omitempty"`     // Массив кассиров
 	Shared       bool               `json:"shared,omitempty"`       // Общий доступ
 	Archived     bool               `json:"archived,omitempty"`     // Добавлен ли Сотрудник в архив
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetMeta возвращает Метаданные Сотрудника.
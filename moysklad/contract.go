@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -37,11 +38,13 @@ type Contract struct {
 	Updated             *Timestamp        `json:"updated,omitempty"`             // Момент последнего обновления сущности
 	Shared              *bool             `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State] `json:"state,omitempty"`               // Метаданные статуса договора
-	Sum                 *float64          `json:"sum,omitempty"`                 // Сумма Договора
+	Sum                 *Decimal          `json:"sum,omitempty"`                 // Сумма Договора
 	SyncID              *string           `json:"syncId,omitempty"`              // ID синхронизации
 	ContractType        ContractType      `json:"contractType,omitempty"`        // Тип Договора
 	RewardType          RewardType        `json:"rewardType,omitempty"`          // Тип Вознаграждения
 	Attributes          Slice[Attribute]  `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -165,7 +168,7 @@ func (contract Contract) GetState() State {
 }
 
 // GetSum возвращает Сумму Договора.
-func (contract Contract) GetSum() float64 {
+func (contract Contract) GetSum() Decimal {
 	return Deref(contract.Sum)
 }
 
@@ -308,7 +311,7 @@ func (contract *Contract) SetState(state *State) *Contract {
 }
 
 // SetSum устанавливает Сумму Договора.
-func (contract *Contract) SetSum(sum *float64) *Contract {
+func (contract *Contract) SetSum(sum *Decimal) *Contract {
 	contract.Sum = sum
 	return contract
 }
@@ -424,6 +427,11 @@ type ContractService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Contract], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех договоров постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Contract, error]
+
 	// Create выполняет запрос на создание договора.
 	// Обязательные поля для заполнения:
 	//	- name (Номер договора)
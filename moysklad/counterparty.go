@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -43,7 +44,7 @@ type Counterparty struct {
 	Fax                *string                     `json:"fax,omitempty"`                // Номер факса
 	Phone              *string                     `json:"phone,omitempty"`              // Номер городского телефона
 	PriceType          *PriceType                  `json:"priceType,omitempty"`          // Тип цены Контрагента
-	SalesAmount        *float64                    `json:"salesAmount,omitempty"`        // Сумма продаж
+	SalesAmount        *Decimal                    `json:"salesAmount,omitempty"`        // Сумма продаж
 	Shared             *bool                       `json:"shared,omitempty"`             // Общий доступ
 	State              *NullValue[State]           `json:"state,omitempty"`              // Метаданные Статуса Контрагента
 	SyncID             *string                     `json:"syncId,omitempty"`             // ID синхронизации
@@ -64,6 +65,8 @@ type Counterparty struct {
 	CompanyType        CompanyType                 `json:"companyType,omitempty"`        // Тип Контрагента. В зависимости от значения данного поля набор выводимых реквизитов контрагента может меняться.
 	Sex                Sex                         `json:"sex,omitempty"`                // Пол Контрагента
 	Attributes         Slice[Attribute]            `json:"attributes,omitempty"`         // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -234,7 +237,7 @@ func (counterparty Counterparty) GetPriceType() PriceType {
 }
 
 // GetSalesAmount возвращает Сумму продаж.
-func (counterparty Counterparty) GetSalesAmount() float64 {
+func (counterparty Counterparty) GetSalesAmount() Decimal {
 	return Deref(counterparty.SalesAmount)
 }
 
@@ -656,7 +659,7 @@ func (counterparty *Counterparty) Delete(ctx context.Context, client *Client) (b
 type CounterpartyDiscount struct {
 	Discount             *MetaWrapper `json:"discount,omitempty"`             // Метаданные Скидки
 	PersonalDiscount     *float64     `json:"personalDiscount,omitempty"`     // Значение персональной скидки
-	DemandSumCorrection  *float64     `json:"demandSumCorrection,omitempty"`  // Коррекция суммы накоплений по скидке
+	DemandSumCorrection  *Decimal     `json:"demandSumCorrection,omitempty"`  // Коррекция суммы накоплений по скидке
 	AccumulationDiscount *float64     `json:"accumulationDiscount,omitempty"` // Значение накопительной скидки
 }
 
@@ -676,6 +679,8 @@ type CounterpartySettings struct {
 	Meta            *Meta            `json:"meta,omitempty"`            // Метаданные Настроек справочника контрагентов
 	CreateShared    *bool            `json:"createShared,omitempty"`    // Создавать новые документы с меткой «Общий»
 	UniqueCodeRules *UniqueCodeRules `json:"uniqueCodeRules,omitempty"` // Настройки кодов контрагентов
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetMeta возвращает Метаданные Настроек справочника контрагентов.
@@ -731,6 +736,8 @@ type Note struct {
 	Description       *string       `json:"description,omitempty"`       // Текст события Контрагента
 	ID                *string       `json:"id,omitempty"`                // ID события контрагента
 	Meta              *Meta         `json:"meta,omitempty"`              // Метаданные события контрагента
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -823,6 +830,11 @@ type CounterpartyService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Counterparty], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех контрагентов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Counterparty, error]
+
 	// Create выполняет запрос на создание контрагента.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование контрагента)
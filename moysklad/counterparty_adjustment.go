@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -34,8 +35,10 @@ type CounterpartyAdjustment struct {
 	ID           *string          `json:"id,omitempty"`           // ID Корректировки взаиморасчетов
 	Published    *bool            `json:"published,omitempty"`    // Опубликован ли документ
 	Shared       *bool            `json:"shared,omitempty"`       // Общий доступ
-	Sum          *float64         `json:"sum,omitempty"`          // Сумма Корректировки взаиморасчетов в копейках
+	Sum          *Decimal         `json:"sum,omitempty"`          // Сумма Корректировки взаиморасчетов в копейках
 	Attributes   Slice[Attribute] `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -149,7 +152,7 @@ func (counterPartyAdjustment CounterpartyAdjustment) GetShared() bool {
 }
 
 // GetSum возвращает Сумму Корректировки взаиморасчетов в копейках.
-func (counterPartyAdjustment CounterpartyAdjustment) GetSum() float64 {
+func (counterPartyAdjustment CounterpartyAdjustment) GetSum() Decimal {
 	return Deref(counterPartyAdjustment.Sum)
 }
 
@@ -285,6 +288,11 @@ type CounterPartyAdjustmentService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CounterpartyAdjustment], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех корректировок взаиморасчётов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CounterpartyAdjustment, error]
+
 	// Create выполняет запрос на создание корректировки взаиморасчётов.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
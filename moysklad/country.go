@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -26,6 +27,8 @@ type Country struct {
 	Owner        *Employee  `json:"owner,omitempty"`        // Метаданные владельца (Сотрудника)
 	Shared       *bool      `json:"shared,omitempty"`       // Общий доступ
 	Updated      *Timestamp `json:"updated,omitempty"`      // Момент последнего обновления Страны
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -182,6 +185,11 @@ type CountryService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Country], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех стран постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Country, error]
+
 	// Create выполняет запрос на создание страны.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование страны)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Currency Валюта.
@@ -29,6 +30,8 @@ type Currency struct {
 	Rate           *float64       `json:"rate,omitempty"`           // Курс Валюты
 	System         *bool          `json:"system,omitempty"`         // Основана ли валюта на валюте из системного справочника
 	RateUpdateType RateUpdateType `json:"rateUpdateType,omitempty"` // Способ обновления курса Валюты
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -315,6 +318,11 @@ type CurrencyService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Currency], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех валют постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Currency, error]
+
 	// Create выполняет запрос на создание валюты.
 	// Обязательные поля для заполнения:
 	//	- name (Краткое наименование Валюты)
@@ -19,6 +19,8 @@ type CustomEntity struct {
 	ID   *string `json:"id,omitempty"`   // ID Пользовательского справочника
 	Meta *Meta   `json:"meta,omitempty"` // Метаданные Пользовательского справочника
 	Name *string `json:"name,omitempty"` // Наименование Пользовательского справочника
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -100,6 +102,8 @@ type CustomEntityElement struct {
 	Group        *Group     `json:"group,omitempty"`        // Отдел сотрудника
 	Owner        *Employee  `json:"owner,omitempty"`        // Метаданные владельца (Сотрудника)
 	Shared       *bool      `json:"shared,omitempty"`       // Общий доступ
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
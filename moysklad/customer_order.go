@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 	"net/http"
 
 	"time"
@@ -32,33 +33,33 @@ type CustomerOrder struct {
 	Files                 *MetaArray[File]                  `json:"files,omitempty"`                 // Метаданные массива Файлов (Максимальное количество файлов - 100)
 	Group                 *Group                            `json:"group,omitempty"`                 // Отдел сотрудника
 	ID                    *string                           `json:"id,omitempty"`                    // ID Заказа покупателя
-	InvoicedSum           *float64                          `json:"invoicedSum,omitempty"`           // Сумма счетов покупателю
+	InvoicedSum           *Decimal                          `json:"invoicedSum,omitempty"`           // Сумма счетов покупателю
 	Meta                  *Meta                             `json:"meta,omitempty"`                  // Метаданные Заказа покупателя
 	Name                  *string                           `json:"name,omitempty"`                  // Наименование Заказа покупателя
 	Moment                *Timestamp                        `json:"moment,omitempty"`                // Дата документа
 	Organization          *Organization                     `json:"organization,omitempty"`          // Метаданные юрлица
 	Printed               *bool                             `json:"printed,omitempty"`               // Напечатан ли документ
 	Owner                 *Employee                         `json:"owner,omitempty"`                 // Метаданные владельца (Сотрудника)
-	PayedSum              *float64                          `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
+	PayedSum              *Decimal                          `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
 	Positions             *MetaArray[CustomerOrderPosition] `json:"positions,omitempty"`             // Метаданные позиций Заказа покупателя
 	AccountID             *string                           `json:"accountId,omitempty"`             // ID учётной записи
 	Contract              *NullValue[Contract]              `json:"contract,omitempty"`              // Метаданные договора
 	Published             *bool                             `json:"published,omitempty"`             // Опубликован ли документ
 	Rate                  *NullValue[Rate]                  `json:"rate,omitempty"`                  // Валюта
-	ReservedSum           *float64                          `json:"reservedSum,omitempty"`           // Сумма товаров в резерве
+	ReservedSum           *Decimal                          `json:"reservedSum,omitempty"`           // Сумма товаров в резерве
 	SalesChannel          *NullValue[SalesChannel]          `json:"salesChannel,omitempty"`          // Метаданные канала продаж
 	Shared                *bool                             `json:"shared,omitempty"`                // Общий доступ
 	ShipmentAddress       *string                           `json:"shipmentAddress,omitempty"`       // Адрес доставки Заказа покупателя
 	ShipmentAddressFull   *Address                          `json:"shipmentAddressFull,omitempty"`   // Адрес доставки Заказа покупателя с детализацией по отдельным полям
-	ShippedSum            *float64                          `json:"shippedSum,omitempty"`            // Сумма отгруженного
+	ShippedSum            *Decimal                          `json:"shippedSum,omitempty"`            // Сумма отгруженного
 	State                 *NullValue[State]                 `json:"state,omitempty"`                 // Метаданные статуса заказа
 	Store                 *NullValue[Store]                 `json:"store,omitempty"`                 // Метаданные склада
-	Sum                   *float64                          `json:"sum,omitempty"`                   // Сумма Заказа в установленной валюте
+	Sum                   *Decimal                          `json:"sum,omitempty"`                   // Сумма Заказа в установленной валюте
 	SyncID                *string                           `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                        `json:"updated,omitempty"`               // Момент последнего обновления Заказа покупателя
 	VatEnabled            *bool                             `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
 	VatIncluded           *bool                             `json:"vatIncluded,omitempty"`           // Включен ли НДС в цену
-	VatSum                *float64                          `json:"vatSum,omitempty"`                // Сумма НДС
+	VatSum                *Decimal                          `json:"vatSum,omitempty"`                // Сумма НДС
 	Prepayments           Slice[Prepayment]                 `json:"prepayments,omitempty"`           // Массив ссылок на связанные предоплаты
 	PurchaseOrders        Slice[PurchaseOrder]              `json:"purchaseOrders,omitempty"`        // Массив ссылок на связанные заказы поставщикам
 	Demands               Slice[Demand]                     `json:"demands,omitempty"`               // Массив ссылок на связанные отгрузки
@@ -66,6 +67,8 @@ type CustomerOrder struct {
 	InvoicesOut           Slice[InvoiceOut]                 `json:"invoicesOut,omitempty"`           // Массив ссылок на связанные счета покупателям
 	TaxSystem             TaxSystem                         `json:"taxSystem,omitempty"`             // Код системы налогообложения
 	Attributes            Slice[Attribute]                  `json:"attributes,omitempty"`            // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -169,7 +172,7 @@ func (customerOrder CustomerOrder) GetID() string {
 }
 
 // GetInvoicedSum возвращает Сумму счетов покупателю.
-func (customerOrder CustomerOrder) GetInvoicedSum() float64 {
+func (customerOrder CustomerOrder) GetInvoicedSum() Decimal {
 	return Deref(customerOrder.InvoicedSum)
 }
 
@@ -204,7 +207,7 @@ func (customerOrder CustomerOrder) GetOwner() Employee {
 }
 
 // GetPayedSum возвращает Оплаченную сумму.
-func (customerOrder CustomerOrder) GetPayedSum() float64 {
+func (customerOrder CustomerOrder) GetPayedSum() Decimal {
 	return Deref(customerOrder.PayedSum)
 }
 
@@ -234,7 +237,7 @@ func (customerOrder CustomerOrder) GetRate() Rate {
 }
 
 // GetReservedSum возвращает Сумму товаров в резерве.
-func (customerOrder CustomerOrder) GetReservedSum() float64 {
+func (customerOrder CustomerOrder) GetReservedSum() Decimal {
 	return Deref(customerOrder.ReservedSum)
 }
 
@@ -259,7 +262,7 @@ func (customerOrder CustomerOrder) GetShipmentAddressFull() Address {
 }
 
 // GetShippedSum возвращает Сумму отгруженного.
-func (customerOrder CustomerOrder) GetShippedSum() float64 {
+func (customerOrder CustomerOrder) GetShippedSum() Decimal {
 	return Deref(customerOrder.ShippedSum)
 }
 
@@ -274,7 +277,7 @@ func (customerOrder CustomerOrder) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Заказа в установленной валюте.
-func (customerOrder CustomerOrder) GetSum() float64 {
+func (customerOrder CustomerOrder) GetSum() Decimal {
 	return Deref(customerOrder.Sum)
 }
 
@@ -304,7 +307,7 @@ func (customerOrder CustomerOrder) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (customerOrder CustomerOrder) GetVatSum() float64 {
+func (customerOrder CustomerOrder) GetVatSum() Decimal {
 	return Deref(customerOrder.VatSum)
 }
 
@@ -635,26 +638,28 @@ func (customerOrder *CustomerOrder) Delete(ctx context.Context, client *Client)
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-zakaz-pokupatelq-zakazy-pokupatelej-pozicii-zakaza-pokupatelq
 type CustomerOrderPosition struct {
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
-	Reserve    *float64            `json:"reserve,omitempty"`    // Резерв данной позиции
-	Shipped    *float64            `json:"shipped,omitempty"`    // Доставлено
+	Reserve    *Decimal            `json:"reserve,omitempty"`    // Резерв данной позиции
+	Shipped    *Decimal            `json:"shipped,omitempty"`    // Доставлено
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	TaxSystem  TaxSystem           `json:"taxSystem,omitempty"`  // Код системы налогообложения
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в позиции.
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (customerOrderPosition CustomerOrderPosition) GetQuantity() float64 {
+func (customerOrderPosition CustomerOrderPosition) GetQuantity() Decimal {
 	return Deref(customerOrderPosition.Quantity)
 }
 
@@ -679,7 +684,7 @@ func (customerOrderPosition CustomerOrderPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (customerOrderPosition CustomerOrderPosition) GetPrice() float64 {
+func (customerOrderPosition CustomerOrderPosition) GetPrice() Decimal {
 	return Deref(customerOrderPosition.Price)
 }
 
@@ -689,12 +694,12 @@ func (customerOrderPosition CustomerOrderPosition) GetAccountID() string {
 }
 
 // GetReserve возвращает Резерв данной позиции.
-func (customerOrderPosition CustomerOrderPosition) GetReserve() float64 {
+func (customerOrderPosition CustomerOrderPosition) GetReserve() Decimal {
 	return Deref(customerOrderPosition.Reserve)
 }
 
 // GetShipped возвращает Доставлено.
-func (customerOrderPosition CustomerOrderPosition) GetShipped() float64 {
+func (customerOrderPosition CustomerOrderPosition) GetShipped() Decimal {
 	return Deref(customerOrderPosition.Shipped)
 }
 
@@ -719,7 +724,7 @@ func (customerOrderPosition CustomerOrderPosition) GetTaxSystem() TaxSystem {
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (customerOrderPosition *CustomerOrderPosition) SetQuantity(quantity float64) *CustomerOrderPosition {
+func (customerOrderPosition *CustomerOrderPosition) SetQuantity(quantity Decimal) *CustomerOrderPosition {
 	customerOrderPosition.Quantity = &quantity
 	return customerOrderPosition
 }
@@ -749,13 +754,13 @@ func (customerOrderPosition *CustomerOrderPosition) SetPack(pack *Pack) *Custome
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (customerOrderPosition *CustomerOrderPosition) SetPrice(price float64) *CustomerOrderPosition {
+func (customerOrderPosition *CustomerOrderPosition) SetPrice(price Decimal) *CustomerOrderPosition {
 	customerOrderPosition.Price = &price
 	return customerOrderPosition
 }
 
 // SetReserve устанавливает Резерв данной позиции.
-func (customerOrderPosition *CustomerOrderPosition) SetReserve(reserve float64) *CustomerOrderPosition {
+func (customerOrderPosition *CustomerOrderPosition) SetReserve(reserve Decimal) *CustomerOrderPosition {
 	customerOrderPosition.Reserve = &reserve
 	return customerOrderPosition
 }
@@ -840,6 +845,11 @@ type CustomerOrderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[CustomerOrder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех заказов покупателей постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*CustomerOrder, error]
+
 	// Create выполняет запрос на создание заказа покупателя.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -891,6 +901,11 @@ type CustomerOrderService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[CustomerOrderPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*CustomerOrderPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -1157,8 +1172,10 @@ func (service *customerOrderService) UpdateNote(ctx context.Context, id string,
 func (service *customerOrderService) DeleteNoteByID(ctx context.Context, id string, noteID string) (bool, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointCustomerOrderNotesID, id, noteID)
 	_, resp, err := NewRequestBuilder[EventNote](service.client, path).Delete(ctx)
-
-	return resp.StatusCode() == http.StatusNoContent, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusNoContent, resp, nil
 }
 
 // NewCustomerOrderService принимает [Client] и возвращает сервис для работы с заказами покупателя.
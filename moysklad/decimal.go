@@ -0,0 +1,311 @@
+package moysklad
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DecimalPlaces количество знаков после запятой, хранимых в [Decimal].
+//
+// Суммы и цены в API передаются в копейках, поэтому точность [Decimal] – одна десятитысячная копейки.
+const DecimalPlaces = 4
+
+const decimalFactor = 10000 // 10^DecimalPlaces
+
+var bigDecimalFactor = big.NewInt(decimalFactor)
+
+// Decimal десятичное число с фиксированной точностью [DecimalPlaces] знаков после запятой.
+//
+// Используется для сумм, цен и количеств вместо float64: сложение и вычитание выполняются без погрешности,
+// умножение и деление округляются до [DecimalPlaces] знаков по правилам математического округления.
+//
+// Диапазон значений не ограничен: значения, не умещающиеся в int64, хранятся в [big.Int],
+// поэтому арифметические операции не переполняются.
+//
+// Сериализуется в JSON как число, при декодировании принимает число или строку.
+// Нулевое значение соответствует числу 0. Для сравнения значений используйте [Decimal.Equal] или [Decimal.Cmp].
+type Decimal struct {
+	value int64    // значение, умноженное на 10^DecimalPlaces
+	big   *big.Int // значение, умноженное на 10^DecimalPlaces, если оно не умещается в int64; не изменяется
+}
+
+// NewDecimal возвращает [Decimal], равный value * 10^exp.
+//
+// Например, NewDecimal(12345, -2) равен 123.45.
+func NewDecimal(value int64, exp int32) Decimal {
+	return decimalFromRat(new(big.Rat).Mul(new(big.Rat).SetInt64(value), pow10Rat(exp)))
+}
+
+// NewDecimalFromInt возвращает [Decimal], равный целому числу value.
+func NewDecimalFromInt(value int64) Decimal {
+	return decimalFromBig(new(big.Int).Mul(big.NewInt(value), bigDecimalFactor))
+}
+
+// NewDecimalFromFloat возвращает [Decimal], равный value, округлённому до [DecimalPlaces] знаков.
+//
+// Используется кратчайшее десятичное представление value, поэтому NewDecimalFromFloat(0.1) равен ровно 0.1.
+// Для NaN и бесконечностей возвращается 0.
+func NewDecimalFromFloat(value float64) Decimal {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Decimal{}
+	}
+	rat, _ := new(big.Rat).SetString(strconv.FormatFloat(value, 'g', -1, 64))
+	return decimalFromRat(rat)
+}
+
+// ParseDecimal преобразует строку вида "123.45", "-0.5" или "1e3" в [Decimal].
+//
+// Знаки после [DecimalPlaces] округляются. Возвращает ошибку, если строка не является числом.
+func ParseDecimal(s string) (Decimal, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok || strings.ContainsRune(s, '/') {
+		return Decimal{}, fmt.Errorf("moysklad: invalid decimal %q", s)
+	}
+	return decimalFromRat(rat), nil
+}
+
+// MustParseDecimal аналогичен [ParseDecimal], но вызывает панику при ошибке.
+func MustParseDecimal(s string) Decimal {
+	decimal, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return decimal
+}
+
+// RublesToKopecks преобразует сумму в рублях в копейки.
+func RublesToKopecks(rubles Decimal) Decimal {
+	return decimalFromBig(rubles.bigInt().Mul(rubles.bigInt(), big.NewInt(100)))
+}
+
+// KopecksToRubles преобразует сумму в копейках в рубли.
+//
+// Результат округляется до [DecimalPlaces] знаков.
+func KopecksToRubles(kopecks Decimal) Decimal {
+	return kopecks.Div(NewDecimalFromInt(100))
+}
+
+// SumDecimals возвращает сумму значений.
+func SumDecimals(values ...Decimal) Decimal {
+	var sum Decimal
+	for _, value := range values {
+		sum = sum.Add(value)
+	}
+	return sum
+}
+
+// Add возвращает decimal + other.
+func (decimal Decimal) Add(other Decimal) Decimal {
+	if decimal.big == nil && other.big == nil {
+		sum := decimal.value + other.value
+		// переполнение возможно только при сложении чисел одного знака
+		if (sum >= decimal.value) == (other.value >= 0) {
+			return Decimal{value: sum}
+		}
+	}
+	return decimalFromBig(new(big.Int).Add(decimal.bigInt(), other.bigInt()))
+}
+
+// Sub возвращает decimal - other.
+func (decimal Decimal) Sub(other Decimal) Decimal {
+	return decimal.Add(other.Neg())
+}
+
+// Mul возвращает decimal * other, округлённое до [DecimalPlaces] знаков.
+func (decimal Decimal) Mul(other Decimal) Decimal {
+	product := new(big.Int).Mul(decimal.bigInt(), other.bigInt())
+	return decimalFromBig(divRound(product, bigDecimalFactor))
+}
+
+// Div возвращает decimal / other, округлённое до [DecimalPlaces] знаков.
+//
+// Как и целочисленное деление, вызывает панику, если other равен 0.
+func (decimal Decimal) Div(other Decimal) Decimal {
+	if other.IsZero() {
+		panic("moysklad: decimal division by zero")
+	}
+	dividend := new(big.Int).Mul(decimal.bigInt(), bigDecimalFactor)
+	return decimalFromBig(divRound(dividend, other.bigInt()))
+}
+
+// Neg возвращает -decimal.
+func (decimal Decimal) Neg() Decimal {
+	if decimal.big == nil && decimal.value != math.MinInt64 {
+		return Decimal{value: -decimal.value}
+	}
+	return decimalFromBig(new(big.Int).Neg(decimal.bigInt()))
+}
+
+// Abs возвращает модуль decimal.
+func (decimal Decimal) Abs() Decimal {
+	if decimal.Sign() < 0 {
+		return decimal.Neg()
+	}
+	return decimal
+}
+
+// Round округляет decimal до places знаков после запятой по правилам математического округления.
+//
+// Отрицательное значение places округляет до десятков, сотен и т.д.
+// Например, сумму в копейках до целых копеек округляет Round(0).
+func (decimal Decimal) Round(places int32) Decimal {
+	if places >= DecimalPlaces {
+		return decimal
+	}
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(DecimalPlaces-places)), nil)
+	rounded := divRound(decimal.bigInt(), unit)
+	return decimalFromBig(rounded.Mul(rounded, unit))
+}
+
+// Cmp сравнивает decimal и other и возвращает -1, если decimal < other, 0, если decimal == other, и +1, если decimal > other.
+func (decimal Decimal) Cmp(other Decimal) int {
+	if decimal.big != nil || other.big != nil {
+		return decimal.bigInt().Cmp(other.bigInt())
+	}
+
+	switch {
+	case decimal.value < other.value:
+		return -1
+	case decimal.value > other.value:
+		return 1
+	}
+	return 0
+}
+
+// Equal возвращает true, если decimal == other.
+func (decimal Decimal) Equal(other Decimal) bool {
+	return decimal.Cmp(other) == 0
+}
+
+// Sign возвращает -1, 0 или +1 в зависимости от знака decimal.
+func (decimal Decimal) Sign() int {
+	if decimal.big != nil {
+		return decimal.big.Sign()
+	}
+	return decimal.Cmp(Decimal{})
+}
+
+// IsZero возвращает true, если decimal равен 0.
+func (decimal Decimal) IsZero() bool {
+	return decimal.Sign() == 0
+}
+
+// Int64 возвращает целую часть decimal и true, если она умещается в int64.
+func (decimal Decimal) Int64() (int64, bool) {
+	if decimal.big == nil {
+		return decimal.value / decimalFactor, true
+	}
+	intPart := new(big.Int).Quo(decimal.big, bigDecimalFactor)
+	return intPart.Int64(), intPart.IsInt64()
+}
+
+// Float64 возвращает ближайшее к decimal значение float64.
+func (decimal Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(decimal.String(), 64)
+	return f
+}
+
+// String реализует интерфейс [fmt.Stringer].
+//
+// Возвращает число без незначащих нулей, например "123.45" или "-0.5".
+func (decimal Decimal) String() string {
+	sign := ""
+	if decimal.Sign() < 0 {
+		sign = "-"
+	}
+
+	intPart, fracPart := new(big.Int).QuoRem(new(big.Int).Abs(decimal.bigInt()), bigDecimalFactor, new(big.Int))
+	if fracPart.Sign() == 0 {
+		return sign + intPart.String()
+	}
+
+	frac := strings.TrimRight(fmt.Sprintf("%0*d", DecimalPlaces, fracPart.Int64()), "0")
+	return sign + intPart.String() + "." + frac
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (decimal Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(decimal.String()), nil
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (decimal *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	} else {
+		s = string(data)
+	}
+
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*decimal = parsed
+	return nil
+}
+
+// bigInt возвращает новый [big.Int] со значением decimal, умноженным на 10^DecimalPlaces.
+func (decimal Decimal) bigInt() *big.Int {
+	if decimal.big != nil {
+		return new(big.Int).Set(decimal.big)
+	}
+	return big.NewInt(decimal.value)
+}
+
+// decimalFromRat округляет rat до [DecimalPlaces] знаков.
+func decimalFromRat(rat *big.Rat) Decimal {
+	return decimalFromBig(roundRat(rat.Mul(rat, new(big.Rat).SetInt(bigDecimalFactor))))
+}
+
+// decimalFromBig возвращает [Decimal] со значением value, умноженным на 10^DecimalPlaces.
+//
+// Значения, умещающиеся в int64, хранятся без [big.Int], чтобы равные значения совпадали при сравнении ==.
+func decimalFromBig(value *big.Int) Decimal {
+	if value.IsInt64() {
+		return Decimal{value: value.Int64()}
+	}
+	return Decimal{big: value}
+}
+
+// roundRat округляет rat до целого, половины округляются от нуля.
+func roundRat(rat *big.Rat) *big.Int {
+	return divRound(new(big.Int).Set(rat.Num()), rat.Denom())
+}
+
+// divRound возвращает x / y, округлённое до целого, половины округляются от нуля.
+func divRound(x, y *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(x, y, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// |rem| * 2 >= |y|
+	if rem.Abs(rem).Lsh(rem, 1).Cmp(new(big.Int).Abs(y)) >= 0 {
+		if x.Sign()*y.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return quo
+}
+
+func pow10Rat(exp int32) *big.Rat {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(exp, -exp))), nil)
+	if exp < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), pow)
+	}
+	return new(big.Rat).SetInt(pow)
+}
@@ -0,0 +1,140 @@
+package moysklad_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "0", want: "0"},
+		{in: "123.45", want: "123.45"},
+		{in: "-0.5", want: "-0.5"},
+		{in: " 7 ", want: "7"},
+		{in: "1e3", want: "1000"},
+		{in: "1.5e-2", want: "0.015"},
+		{in: "0.00005", want: "0.0001"},
+		{in: "0.00004", want: "0"},
+		{in: "-0.00005", want: "-0.0001"},
+		{in: "2.71828", want: "2.7183"},
+		{in: "123456789012345678901234.5678", want: "123456789012345678901234.5678"},
+		{in: "abc", wantErr: true},
+		{in: "1/3", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := moysklad.ParseDecimal(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDecimal(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNewDecimalFromFloat(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want string
+	}{
+		{in: 0, want: "0"},
+		{in: 0.1, want: "0.1"},
+		{in: 0.1 + 0.2, want: "0.3"},
+		{in: 149990.1, want: "149990.1"},
+		{in: -2.5e3, want: "-2500"},
+		{in: 1.23455, want: "1.2346"},
+		{in: 1e-7, want: "0"},
+		{in: 1e20, want: "100000000000000000000"},
+		{in: math.NaN(), want: "0"},
+		{in: math.Inf(1), want: "0"},
+	}
+
+	for _, tt := range tests {
+		if got := moysklad.NewDecimalFromFloat(tt.in); got.String() != tt.want {
+			t.Errorf("NewDecimalFromFloat(%v) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	d := moysklad.MustParseDecimal
+	tests := []struct {
+		name string
+		got  moysklad.Decimal
+		want string
+	}{
+		{name: "add", got: d("0.1").Add(d("0.2")), want: "0.3"},
+		{name: "sub", got: d("1").Sub(d("1.0001")), want: "-0.0001"},
+		{name: "mul rounds half away from zero", got: d("0.0005").Mul(d("0.1")), want: "0.0001"},
+		{name: "mul negative", got: d("-0.0005").Mul(d("0.1")), want: "-0.0001"},
+		{name: "div", got: d("1").Div(d("3")), want: "0.3333"},
+		{name: "div rounds", got: d("2").Div(d("3")), want: "0.6667"},
+		{name: "round", got: d("2.345").Round(2), want: "2.35"},
+		{name: "round negative places", got: d("1250").Round(-2), want: "1300"},
+		{name: "rubles to kopecks", got: moysklad.RublesToKopecks(d("1499.9")), want: "149990"},
+		{name: "kopecks to rubles", got: moysklad.KopecksToRubles(d("149990")), want: "1499.9"},
+		{name: "sum", got: moysklad.SumDecimals(d("0.1"), d("0.1"), d("0.1")), want: "0.3"},
+		{name: "new decimal", got: moysklad.NewDecimal(12345, -2), want: "123.45"},
+		{name: "abs", got: d("-3.5").Abs(), want: "3.5"},
+	}
+
+	for _, tt := range tests {
+		if tt.got.String() != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestDecimalBeyondInt64(t *testing.T) {
+	max := moysklad.NewDecimal(math.MaxInt64, -4)
+	one := moysklad.NewDecimalFromInt(1)
+
+	sum := max.Add(one)
+	if want := "922337203685478.5807"; sum.String() != want {
+		t.Errorf("max + 1 = %s, want %s", sum, want)
+	}
+	if back := sum.Sub(one); back != max {
+		t.Errorf("max + 1 - 1 = %s, want %s comparable with ==", back, max)
+	}
+
+	product := max.Mul(moysklad.NewDecimalFromInt(100000000))
+	if want := "92233720368547758070000"; product.String() != want {
+		t.Errorf("max * 10^8 = %s, want %s", product, want)
+	}
+	if product.Cmp(max) != 1 || max.Neg().Cmp(product.Neg()) != 1 {
+		t.Errorf("unexpected comparison of %s and %s", product, max)
+	}
+	if _, ok := product.Int64(); ok {
+		t.Errorf("%s.Int64() reported fitting into int64", product)
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var position moysklad.CustomerOrderPosition
+	data := []byte(`{"price":123456789012345678901.3456,"quantity":"0.1"}`)
+	if err := json.Unmarshal(data, &position); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "12345678901234567890.1346"; position.GetPrice().Mul(position.GetQuantity()).String() != want {
+		t.Errorf("price * quantity = %s, want %s", position.GetPrice().Mul(position.GetQuantity()), want)
+	}
+
+	encoded, err := json.Marshal(position)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"quantity":0.1,"price":123456789012345678901.3456}`; string(encoded) != want {
+		t.Errorf("json.Marshal = %s, want %s", encoded, want)
+	}
+}
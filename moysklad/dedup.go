@@ -0,0 +1,163 @@
+package moysklad
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// flightGroup объединяет одновременные одинаковые GET-запросы клиента в один HTTP-запрос.
+type flightGroup struct {
+	client *Client
+	calls  map[string]*flight
+	mu     sync.Mutex
+}
+
+// flight выполняемый запрос и ожидающие его результат вызовы.
+type flight struct {
+	done    chan struct{}
+	resp    *resty.Response
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func newFlightGroup(client *Client) *flightGroup {
+	return &flightGroup{client: client, calls: make(map[string]*flight)}
+}
+
+// handler возвращает [Handler], который выполняет одновременные одинаковые GET-запросы однократно.
+//
+// Каждый вызов получает собственную копию ответа и декодирует результат независимо.
+// Общий запрос выполняется с собственными контекстом, спаном и копией запроса, не связанными
+// с вызовом, который его начал; спан общего запроса ссылается на спан этого вызова.
+// Запрос отменяется, только когда контексты всех ожидающих вызовов отменены.
+func (group *flightGroup) handler(next Handler) Handler {
+	return func(ctx context.Context, info *RequestInfo) (*resty.Response, error) {
+		if info.Method != http.MethodGet {
+			return next(ctx, info)
+		}
+
+		key := flightKey(info)
+
+		group.mu.Lock()
+		f, ok := group.calls[key]
+		if !ok {
+			// запрос не должен зависеть от контекста и запроса первого вызова
+			flightCtx, cancel := context.WithCancel(context.Background())
+			flightCtx, span := group.client.tracer.Start(flightCtx, "moysklad coalesced "+info.Method+" "+entityFromURI(info.URI),
+				trace.WithLinks(trace.LinkFromContext(ctx)),
+				trace.WithAttributes(attrEntity.String(entityFromURI(info.URI)), attrURLPath.String(info.URI)),
+			)
+			flightInfo := group.flightInfo(info)
+
+			f = &flight{done: make(chan struct{}), cancel: cancel}
+			group.calls[key] = f
+
+			go func() {
+				defer cancel()
+
+				f.resp, f.err = next(flightCtx, flightInfo)
+				endSpan(span, f.err)
+
+				group.mu.Lock()
+				if group.calls[key] == f {
+					delete(group.calls, key)
+				}
+				group.mu.Unlock()
+
+				close(f.done)
+			}()
+		}
+		f.waiters++
+		group.mu.Unlock()
+
+		trace.SpanFromContext(ctx).AddEvent("request coalesced")
+
+		select {
+		case <-f.done:
+			return copyResponse(f.resp), f.err
+		case <-ctx.Done():
+			group.mu.Lock()
+			f.waiters--
+			if f.waiters == 0 {
+				f.cancel()
+				if group.calls[key] == f {
+					delete(group.calls, key)
+				}
+			}
+			group.mu.Unlock()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// flightInfo возвращает копию info с собственным запросом, в который перенесены заголовки,
+// параметры и данные авторизации запроса info.
+func (group *flightGroup) flightInfo(info *RequestInfo) *RequestInfo {
+	request := group.client.R()
+	request.Header = info.Request.Header.Clone()
+	request.Token = info.Request.Token
+	if info.Request.UserInfo != nil {
+		userInfo := *info.Request.UserInfo
+		request.UserInfo = &userInfo
+	}
+
+	params := make(url.Values, len(info.Params))
+	for key, values := range info.Params {
+		params[key] = slices.Clone(values)
+	}
+
+	flightInfo := *info
+	flightInfo.Request = request
+	flightInfo.Params = params
+	return &flightInfo
+}
+
+// flightKey возвращает ключ запроса: путь, параметры, заголовки и данные авторизации запроса.
+// Данные авторизации клиента одинаковы для всех его запросов и в ключ не входят.
+func flightKey(info *RequestInfo) string {
+	var sb strings.Builder
+
+	sb.WriteString(info.URI)
+	sb.WriteString("?")
+	sb.WriteString(info.Params.Encode())
+
+	request := info.Request
+
+	headerKeys := make([]string, 0, len(request.Header))
+	for key := range request.Header {
+		headerKeys = append(headerKeys, key)
+	}
+	slices.Sort(headerKeys)
+
+	for _, key := range headerKeys {
+		sb.WriteString("\n" + key + ": " + strings.Join(request.Header[key], ","))
+	}
+
+	if request.Token != "" {
+		sb.WriteString("\ntoken: " + request.Token)
+	}
+
+	if request.UserInfo != nil {
+		sb.WriteString("\nuser: " + request.UserInfo.Username + ":" + request.UserInfo.Password)
+	}
+
+	return sb.String()
+}
+
+// copyResponse возвращает копию ответа resp с собственной копией тела.
+func copyResponse(resp *resty.Response) *resty.Response {
+	if resp == nil {
+		return nil
+	}
+
+	dup := *resp
+	return dup.SetBody(bytes.Clone(resp.Body()))
+}
@@ -0,0 +1,102 @@
+package moysklad_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+)
+
+// slowServer возвращает сервер, отвечающий пустым списком с задержкой, и счётчик полученных запросов.
+func slowServer(t *testing.T, delay time.Duration) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		time.Sleep(delay)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"size":0},"rows":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits
+}
+
+func TestRequestCoalescing(t *testing.T) {
+	tests := []struct {
+		name   string
+		enable bool
+		want   int32
+	}{
+		{name: "disabled by default", enable: false, want: 5},
+		{name: "enabled", enable: true, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, hits := slowServer(t, 200*time.Millisecond)
+			client := moysklad.New(moysklad.Config{
+				Token:                   "token",
+				BaseURL:                 server.URL + "/",
+				EnableRequestCoalescing: tt.enable,
+			})
+
+			var wg sync.WaitGroup
+			for range 5 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, _, err := client.Entity().Product().GetList(context.Background()); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := hits.Load(); got != tt.want {
+				t.Errorf("server received %d requests, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestCoalescingFirstCallerCanceled(t *testing.T) {
+	server, hits := slowServer(t, 300*time.Millisecond)
+	client := moysklad.New(moysklad.Config{
+		Token:                   "token",
+		BaseURL:                 server.URL + "/",
+		EnableRequestCoalescing: true,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, _, err := client.Entity().Product().GetList(ctx)
+		first <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	second := make(chan error, 1)
+	go func() {
+		_, _, err := client.Entity().Product().GetList(context.Background())
+		second <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	if err := <-first; err == nil {
+		t.Error("canceled caller returned no error")
+	}
+	if err := <-second; err != nil {
+		t.Errorf("second caller error = %v, want shared response", err)
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
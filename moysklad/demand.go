@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -35,7 +36,7 @@ type Demand struct {
 	OrganizationAccount     *AgentAccount              `json:"organizationAccount,omitempty"`     // Метаданные счета юрлица
 	Overhead                *Overhead                  `json:"overhead,omitempty"`                // Накладные расходы. Если Позиции Отгрузки не заданы, то накладные расходы нельзя задать
 	Owner                   *Employee                  `json:"owner,omitempty"`                   // Метаданные владельца (Сотрудника)
-	PayedSum                *float64                   `json:"payedSum,omitempty"`                // Сумма входящих платежей по Отгрузке
+	PayedSum                *Decimal                   `json:"payedSum,omitempty"`                // Сумма входящих платежей по Отгрузке
 	Positions               *MetaArray[DemandPosition] `json:"positions,omitempty"`               // Метаданные позиций Отгрузки
 	Printed                 *bool                      `json:"printed,omitempty"`                 // Напечатан ли документ
 	Project                 *NullValue[Project]        `json:"project,omitempty"`                 // Метаданные проекта
@@ -47,12 +48,12 @@ type Demand struct {
 	ShipmentAddressFull     *Address                   `json:"shipmentAddressFull,omitempty"`     // Адрес доставки Отгрузки с детализацией по отдельным полям.
 	State                   *NullValue[State]          `json:"state,omitempty"`                   // Метаданные статуса Отгрузки
 	Store                   *Store                     `json:"store,omitempty"`                   // Метаданные склада
-	Sum                     *float64                   `json:"sum,omitempty"`                     // Сумма Отгрузки в копейках
+	Sum                     *Decimal                   `json:"sum,omitempty"`                     // Сумма Отгрузки в копейках
 	SyncID                  *string                    `json:"syncId,omitempty"`                  // ID синхронизации
 	Updated                 *Timestamp                 `json:"updated,omitempty"`                 // Момент последнего обновления Отгрузки
 	VatEnabled              *bool                      `json:"vatEnabled,omitempty"`              // Учитывается ли НДС
 	VatIncluded             *bool                      `json:"vatIncluded,omitempty"`             // Включен ли НДС в цену
-	VatSum                  *float64                   `json:"vatSum,omitempty"`                  // Сумма НДС
+	VatSum                  *Decimal                   `json:"vatSum,omitempty"`                  // Сумма НДС
 	CustomerOrder           *CustomerOrder             `json:"customerOrder,omitempty"`           // Ссылка на Заказ Покупателя, с которым связана эта Отгрузка
 	FactureOut              *FactureOut                `json:"factureOut,omitempty"`              // Ссылка на Счет-фактуру выданный, с которым связана эта Отгрузка
 	Returns                 Slice[SalesReturn]         `json:"returns,omitempty"`                 // Массив ссылок на связанные возвраты
@@ -67,6 +68,8 @@ type Demand struct {
 	TransportFacility       *string                    `json:"transportFacility,omitempty"`       // Транспортное средство
 	TransportFacilityNumber *string                    `json:"transportFacilityNumber,omitempty"` // Номер автомобиля
 	Attributes              Slice[Attribute]           `json:"attributes,omitempty"`              // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -195,7 +198,7 @@ func (demand Demand) GetOwner() Employee {
 }
 
 // GetPayedSum возвращает Оплаченную сумму.
-func (demand Demand) GetPayedSum() float64 {
+func (demand Demand) GetPayedSum() Decimal {
 	return Deref(demand.PayedSum)
 }
 
@@ -255,7 +258,7 @@ func (demand Demand) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Отгрузки в копейках.
-func (demand Demand) GetSum() float64 {
+func (demand Demand) GetSum() Decimal {
 	return Deref(demand.Sum)
 }
 
@@ -280,7 +283,7 @@ func (demand Demand) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (demand Demand) GetVatSum() float64 {
+func (demand Demand) GetVatSum() Decimal {
 	return Deref(demand.VatSum)
 }
 
@@ -694,21 +697,23 @@ func (demand *Demand) Delete(ctx context.Context, client *Client) (bool, *resty.
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-otgruzka-otgruzki-pozicii-otgruzki
 type DemandPosition struct {
 	Slot              *Slot               `json:"slot,omitempty"`               // Ячейка на складе
-	Price             *float64            `json:"price,omitempty"`              // Цена товара/услуги в копейках
-	Cost              *float64            `json:"cost,omitempty"`               // Себестоимость (только для услуг)
+	Price             *Decimal            `json:"price,omitempty"`              // Цена товара/услуги в копейках
+	Cost              *Decimal            `json:"cost,omitempty"`               // Себестоимость (только для услуг)
 	Discount          *float64            `json:"discount,omitempty"`           // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	AccountID         *string             `json:"accountId,omitempty"`          // ID учётной записи
 	Pack              *Pack               `json:"pack,omitempty"`               // Упаковка Товара
 	Assortment        *AssortmentPosition `json:"assortment,omitempty"`         // Метаданные товара/услуги/серии/модификации/комплекта, которую представляет собой позиция
-	Quantity          *float64            `json:"quantity,omitempty"`           // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Quantity          *Decimal            `json:"quantity,omitempty"`           // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	ID                *string             `json:"id,omitempty"`                 // ID позиции
 	Stock             *Stock              `json:"stock,omitempty"`              // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	VatEnabled        *bool               `json:"vatEnabled,omitempty"`         // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Vat               *int                `json:"vat,omitempty"`                // НДС, которым облагается текущая позиция
-	Overhead          *float64            `json:"overhead,omitempty"`           // Накладные расходы
+	Overhead          *Decimal            `json:"overhead,omitempty"`           // Накладные расходы
 	TrackingCodes1162 Slice[TrackingCode] `json:"trackingCodes_1162,omitempty"` // Коды маркировки товаров в формате тега 1162
 	TrackingCodes     Slice[TrackingCode] `json:"trackingCodes,omitempty"`      // Коды маркировки товаров и транспортных упаковок
 	Things            Slice[string]       `json:"things,omitempty"`             // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -722,7 +727,7 @@ func (demandPosition DemandPosition) GetAssortment() AssortmentPosition {
 }
 
 // GetCost возвращает Себестоимость (только для услуг).
-func (demandPosition DemandPosition) GetCost() float64 {
+func (demandPosition DemandPosition) GetCost() Decimal {
 	return Deref(demandPosition.Cost)
 }
 
@@ -744,7 +749,7 @@ func (demandPosition DemandPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (demandPosition DemandPosition) GetPrice() float64 {
+func (demandPosition DemandPosition) GetPrice() Decimal {
 	return Deref(demandPosition.Price)
 }
 
@@ -752,7 +757,7 @@ func (demandPosition DemandPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (demandPosition DemandPosition) GetQuantity() float64 {
+func (demandPosition DemandPosition) GetQuantity() Decimal {
 	return Deref(demandPosition.Quantity)
 }
 
@@ -780,7 +785,7 @@ func (demandPosition DemandPosition) GetTrackingCodes1162() Slice[TrackingCode]
 }
 
 // GetOverhead возвращает Накладные расходы.
-func (demandPosition DemandPosition) GetOverhead() float64 {
+func (demandPosition DemandPosition) GetOverhead() Decimal {
 	return Deref(demandPosition.Overhead)
 }
 
@@ -814,7 +819,7 @@ func (demandPosition *DemandPosition) SetAssortment(assortment AssortmentConvert
 }
 
 // SetCost устанавливает Себестоимость (только для услуг).
-func (demandPosition *DemandPosition) SetCost(cost float64) *DemandPosition {
+func (demandPosition *DemandPosition) SetCost(cost Decimal) *DemandPosition {
 	demandPosition.Cost = &cost
 	return demandPosition
 }
@@ -836,7 +841,7 @@ func (demandPosition *DemandPosition) SetPack(pack *Pack) *DemandPosition {
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (demandPosition *DemandPosition) SetPrice(price float64) *DemandPosition {
+func (demandPosition *DemandPosition) SetPrice(price Decimal) *DemandPosition {
 	demandPosition.Price = &price
 	return demandPosition
 }
@@ -845,7 +850,7 @@ func (demandPosition *DemandPosition) SetPrice(price float64) *DemandPosition {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (demandPosition *DemandPosition) SetQuantity(quantity float64) *DemandPosition {
+func (demandPosition *DemandPosition) SetQuantity(quantity Decimal) *DemandPosition {
 	demandPosition.Quantity = &quantity
 	return demandPosition
 }
@@ -939,6 +944,11 @@ type DemandService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Demand], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех отгрузок постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Demand, error]
+
 	// Create выполняет запрос на создание отгрузки.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -1005,6 +1015,11 @@ type DemandService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[DemandPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*DemandPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Discount Скидка.
@@ -204,6 +205,8 @@ type AccumulationDiscount struct {
 	Assortment     Assortment                `json:"assortment,omitempty"`     // Массив метаданных Товаров и Услуг, которые были выбраны для применения скидки, если та применяется не ко всем товарам
 	ProductFolders *MetaArray[ProductFolder] `json:"productFolders,omitempty"` // Группы товаров которые были выбраны для применения скидки (если применяется не ко всем товарам)
 	Levels         Slice[AccumulationLevel]  `json:"levels,omitempty"`         // Проценты скидок при определенной сумме продаж
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -349,12 +352,12 @@ func (AccumulationDiscount) MetaType() MetaType {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-skidki-levels
 type AccumulationLevel struct {
-	Amount   *float64 `json:"amount,omitempty"`   // Сумма накоплений в копейках
+	Amount   *Decimal `json:"amount,omitempty"`   // Сумма накоплений в копейках
 	Discount *float64 `json:"discount,omitempty"` // Процент скидки, соответствующий данной сумме
 }
 
 // GetAmount возвращает Сумму накоплений в копейках.
-func (accumulationLevel AccumulationLevel) GetAmount() float64 {
+func (accumulationLevel AccumulationLevel) GetAmount() Decimal {
 	return Deref(accumulationLevel.Amount)
 }
 
@@ -364,7 +367,7 @@ func (accumulationLevel AccumulationLevel) GetDiscount() float64 {
 }
 
 // SetAmount устанавливает Сумму накоплений в копейках.
-func (accumulationLevel *AccumulationLevel) SetAmount(amount float64) *AccumulationLevel {
+func (accumulationLevel *AccumulationLevel) SetAmount(amount Decimal) *AccumulationLevel {
 	accumulationLevel.Amount = &amount
 	return accumulationLevel
 }
@@ -394,6 +397,8 @@ type PersonalDiscount struct {
 	ProductFolders *MetaArray[ProductFolder] `json:"productFolders,omitempty"` // Группы товаров которые были выбраны для применения скидки (если применяется не ко всем товарам)
 	AgentTags      Slice[string]             `json:"agentTags,omitempty"`      // Теги контрагентов, к которым применяется скидка, если применяется не ко всем контрагентам
 	Assortment     Assortment                `json:"assortment,omitempty"`     // Массив метаданных Товаров и Услуг, которые были выбраны для применения скидки, если та применяется не ко всем товарам
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -541,6 +546,8 @@ type SpecialPriceDiscount struct {
 	Discount       *float64                  `json:"discount,omitempty"`       // Процент скидки если выбран фиксированный процент
 	SpecialPrice   *SpecialPrice             `json:"specialPrice,omitempty"`   // Спец. цена (если выбран тип цен)
 	AgentTags      Slice[string]             `json:"agentTags,omitempty"`      // Теги контрагентов, к которым применяется скидка, если применяется не ко всем контрагентам
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -751,6 +758,11 @@ type DiscountService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Discount], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех скидок постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Discount, error]
+
 	// UpdateRoundOffDiscount выполняет запрос на изменение округления копеек.
 	// Принимает контекст, ID округления копеек и скидку.
 	// Возвращает скидку.
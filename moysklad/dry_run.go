@@ -0,0 +1,155 @@
+package moysklad
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DryRunEntry запись журнала запроса, не отправленного в режиме пробного запуска.
+type DryRunEntry struct {
+	Time   time.Time       `json:"time"`           // Момент запроса
+	Method string          `json:"method"`         // HTTP-метод
+	URI    string          `json:"uri"`            // Путь запроса относительно базового адреса API
+	Query  string          `json:"query"`          // Параметры запроса
+	Body   json.RawMessage `json:"body,omitempty"` // Тело запроса в формате JSON
+}
+
+// DryRunJournal журнал запросов, не отправленных в режиме пробного запуска.
+//
+// Безопасен для использования из нескольких горутин.
+type DryRunJournal struct {
+	entries []DryRunEntry
+	mu      sync.Mutex
+}
+
+// Entries возвращает копию записей журнала в порядке выполнения запросов.
+func (journal *DryRunJournal) Entries() []DryRunEntry {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	return slices.Clone(journal.entries)
+}
+
+// Len возвращает количество записей журнала.
+func (journal *DryRunJournal) Len() int {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	return len(journal.entries)
+}
+
+// Reset очищает журнал.
+func (journal *DryRunJournal) Reset() {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	journal.entries = nil
+}
+
+func (journal *DryRunJournal) add(entry DryRunEntry) {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	journal.entries = append(journal.entries, entry)
+}
+
+type dryRunContextKey struct{}
+
+// ContextWithDryRun возвращает контекст, в котором режим пробного запуска клиента
+// включён (enabled = true) или отключён (enabled = false) независимо от настройки клиента.
+//
+// Отключение режима для контекста позволяет выполнить изменяющим методом запрос без побочных эффектов,
+// который клиент не распознаёт как таковой.
+//
+// # Пример:
+//
+//	ctx = moysklad.ContextWithDryRun(ctx, true)
+//
+//	// запрос не будет отправлен, а будет записан в журнал client.DryRunJournal()
+//	product, _, err := client.Entity().Product().Update(ctx, productID, product)
+func ContextWithDryRun(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, enabled)
+}
+
+// SetDryRun включает или отключает режим пробного запуска клиента.
+func (client *Client) SetDryRun(enabled bool) *Client {
+	client.dryRun.Store(enabled)
+	return client
+}
+
+// DryRunJournal возвращает журнал запросов, не отправленных в режиме пробного запуска.
+func (client *Client) DryRunJournal() *DryRunJournal {
+	return client.journal
+}
+
+// isDryRun возвращает «true», если для контекста ctx включён режим пробного запуска.
+func (client *Client) isDryRun(ctx context.Context) bool {
+	if enabled, ok := ctx.Value(dryRunContextKey{}).(bool); ok {
+		return enabled
+	}
+	return client.dryRun.Load()
+}
+
+// dryRunHandler возвращает [Handler], который в режиме пробного запуска не отправляет
+// изменяющие запросы (POST, PUT, DELETE), а записывает их в журнал и возвращает тело запроса в качестве ответа.
+//
+// Запросы без побочных эффектов (см. [isReadOnlyRequest]) и запросы с явно указанными логином и паролем
+// (например, запрос получения токена) выполняются всегда.
+func (client *Client) dryRunHandler(next Handler) Handler {
+	return func(ctx context.Context, info *RequestInfo) (*resty.Response, error) {
+		if isReadOnlyRequest(info) || info.Request.UserInfo != nil || !client.isDryRun(ctx) {
+			return next(ctx, info)
+		}
+
+		var body []byte
+		if info.Body != nil {
+			var err error
+			if body, err = json.Marshal(info.Body); err != nil {
+				return nil, err
+			}
+		}
+
+		client.journal.add(DryRunEntry{
+			Time:   time.Now(),
+			Method: info.Method,
+			URI:    info.URI,
+			Query:  info.Params.Encode(),
+			Body:   body,
+		})
+
+		entry := &CacheEntry{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {ApplicationJson}},
+			Body:       body,
+		}
+		return entry.response(info.Request), nil
+	}
+}
+
+// isReadOnlyRequest возвращает «true» для запросов, которые не изменяют данные учётной записи:
+// GET-запросов, запросов отчётов (report/...) и автозаполнения (wizard/...), печати документов
+// и этикеток (.../export) и получения шаблонов документов (.../new).
+func isReadOnlyRequest(info *RequestInfo) bool {
+	if info.Method == http.MethodGet {
+		return true
+	}
+
+	segments := uriSegments(info.URI)
+	switch segments[0] {
+	case "report", "wizard":
+		return true
+	}
+
+	switch segments[len(segments)-1] {
+	case "export":
+		return info.Method == http.MethodPost
+	case "new":
+		return info.Method == http.MethodPut
+	}
+	return false
+}
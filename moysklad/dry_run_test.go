@@ -0,0 +1,45 @@
+package moysklad_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+	"github.com/EnOane/go-moysklad/moysklad/moyskladtest"
+)
+
+func TestDryRun(t *testing.T) {
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{DryRun: true})
+	ctx := context.Background()
+
+	product := new(moysklad.Product).SetName("Товар")
+	if _, _, err := client.Entity().Product().Create(ctx, product); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(server.Requests()); got != 0 {
+		t.Errorf("server received %d requests, want 0", got)
+	}
+	if entries := client.DryRunJournal().Entries(); len(entries) != 1 || entries[0].Method != http.MethodPost {
+		t.Errorf("journal = %v, want one POST entry", entries)
+	}
+
+	// запрос отчёта методом POST не изменяет данные и отправляется
+	_, _, _ = client.Report().Counterparty().GetByCounterparties(ctx)
+	if requests := server.Requests(); len(requests) != 1 || requests[0].Path != "report/counterparty" {
+		t.Errorf("server requests = %v, want report/counterparty", requests)
+	}
+
+	// изменяющий запрос в контексте с отключённым пробным запуском отправляется
+	if _, _, err := client.Entity().Product().Create(moysklad.ContextWithDryRun(ctx, false), product); err != nil {
+		t.Fatal(err)
+	}
+	if got := server.Count(moysklad.MetaTypeProduct); got != 1 {
+		t.Errorf("server stored %d products, want 1", got)
+	}
+	if got := client.DryRunJournal().Len(); got != 1 {
+		t.Errorf("journal has %d entries, want 1", got)
+	}
+}
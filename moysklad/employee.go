@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"net/http"
 	"time"
@@ -44,6 +45,8 @@ type Employee struct {
 	ShortFio     *string             `json:"shortFio,omitempty"`     // Краткое ФИО
 	UID          *string             `json:"uid,omitempty"`          // Логин Сотрудника
 	Attributes   Slice[Attribute]    `json:"attributes,omitempty"`   // Дополнительные поля Сотрудника
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -315,7 +318,7 @@ func (employee *Employee) SetPosition(position string) *Employee {
 }
 
 // SetSalary устанавливает Оклад сотрудника.
-func (employee *Employee) SetSalary(salary float64) *Employee {
+func (employee *Employee) SetSalary(salary Decimal) *Employee {
 	employee.Salary = &Salary{&salary}
 	return employee
 }
@@ -365,16 +368,16 @@ func (employee *Employee) Delete(ctx context.Context, client *Client) (bool, *re
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-sotrudnik-sotrudniki-atributy-wlozhennyh-suschnostej-oklad
 type Salary struct {
-	Value *float64 `json:"value,omitempty"` // Сумма оклада
+	Value *Decimal `json:"value,omitempty"` // Сумма оклада
 }
 
 // GetValue возвращает Сумму оклада.
-func (salary Salary) GetValue() float64 {
+func (salary Salary) GetValue() Decimal {
 	return Deref(salary.Value)
 }
 
 // SetValue устанавливает Сумму оклада.
-func (salary *Salary) SetValue(value float64) *Salary {
+func (salary *Salary) SetValue(value Decimal) *Salary {
 	salary.Value = &value
 	return salary
 }
@@ -516,6 +519,11 @@ type EmployeeService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Employee], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех сотрудников постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Employee, error]
+
 	// Create выполняет запрос на создание сотрудника.
 	// Обязательные поля для заполнения:
 	//	- lastName (Фамилия)
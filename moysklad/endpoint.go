@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"io"
 	"net/http"
@@ -152,6 +153,11 @@ func (endpoint *endpointGetList[T]) GetListAll(ctx context.Context, params ...fu
 	return getAll[T](ctx, endpoint.client, endpoint.uri, params)
 }
 
+// GetListSeq возвращает итератор для получения всех объектов постранично по мере обхода.
+func (endpoint *endpointGetList[T]) GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*T, error] {
+	return listSeq[T](ctx, endpoint.client, endpoint.uri, params)
+}
+
 type endpointDeleteByID struct{ Endpoint }
 
 // DeleteByID выполняет запрос на удаление объекта по ID.
@@ -564,6 +570,12 @@ func (endpoint *endpointPositions[T]) GetPositionListAll(ctx context.Context, id
 	return getAll[T](ctx, endpoint.client, path, params)
 }
 
+// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+func (endpoint *endpointPositions[T]) GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*T, error] {
+	path := fmt.Sprintf(EndpointPositions, endpoint.uri, id)
+	return listSeq[T](ctx, endpoint.client, path, params)
+}
+
 // GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 func (endpoint *endpointPositions[T]) GetPositionByID(ctx context.Context, id, positionID string, params ...func(*Params)) (*T, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointPositionsID, endpoint.uri, id, positionID)
@@ -857,6 +869,9 @@ type endpointTrash struct{ Endpoint }
 func (endpoint *endpointTrash) MoveToTrash(ctx context.Context, id string) (bool, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointTrash, endpoint.uri, id)
 	_, resp, err := NewRequestBuilder[any](endpoint.client, path).Post(ctx, nil)
+	if resp == nil {
+		return false, resp, err
+	}
 	return resp.StatusCode() == http.StatusOK, resp, err
 }
 
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-oprihodowanie
 type Enter struct {
 	Organization *Organization             `json:"organization,omitempty"` // Метаданные юрлица
-	Sum          *float64                  `json:"sum,omitempty"`          // Сумма Оприходования в копейках
+	Sum          *Decimal                  `json:"sum,omitempty"`          // Сумма Оприходования в копейках
 	Moment       *Timestamp                `json:"moment,omitempty"`       // Дата документа
 	Code         *string                   `json:"code,omitempty"`         // Код Оприходования
 	Created      *Timestamp                `json:"created,omitempty"`      // Дата создания
@@ -43,6 +44,8 @@ type Enter struct {
 	Name         *string                   `json:"name,omitempty"`         // Номер Оприходования
 	SyncID       *string                   `json:"syncId,omitempty"`       // ID синхронизации
 	Attributes   Slice[Attribute]          `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -66,7 +69,7 @@ func (enter Enter) GetOrganization() Organization {
 }
 
 // GetSum возвращает Сумму Оприходования в копейках.
-func (enter Enter) GetSum() float64 {
+func (enter Enter) GetSum() Decimal {
 	return Deref(enter.Sum)
 }
 
@@ -382,13 +385,15 @@ type EnterPosition struct {
 	Country    *NullValue[Country] `json:"country,omitempty"`    // Метаданные Страны
 	GTD        *GTD                `json:"gtd,omitempty"`        // ГТД
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Overhead   *float64            `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Оприходования не заданы, то накладные расходы нельзя задать
+	Overhead   *Decimal            `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Оприходования не заданы, то накладные расходы нельзя задать
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Reason     *string             `json:"reason,omitempty"`     // Причина оприходования данной позиции
 	Slot       *Slot               `json:"slot,omitempty"`       // Ячейка на складе
 	Things     Slice[string]       `json:"things,omitempty"`     // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -422,7 +427,7 @@ func (enterPosition EnterPosition) GetID() string {
 }
 
 // GetOverhead возвращает Накладные расходы.
-func (enterPosition EnterPosition) GetOverhead() float64 {
+func (enterPosition EnterPosition) GetOverhead() Decimal {
 	return Deref(enterPosition.Overhead)
 }
 
@@ -432,7 +437,7 @@ func (enterPosition EnterPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (enterPosition EnterPosition) GetPrice() float64 {
+func (enterPosition EnterPosition) GetPrice() Decimal {
 	return Deref(enterPosition.Price)
 }
 
@@ -440,7 +445,7 @@ func (enterPosition EnterPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (enterPosition EnterPosition) GetQuantity() float64 {
+func (enterPosition EnterPosition) GetQuantity() Decimal {
 	return Deref(enterPosition.Quantity)
 }
 
@@ -497,7 +502,7 @@ func (enterPosition *EnterPosition) SetPack(pack *Pack) *EnterPosition {
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (enterPosition *EnterPosition) SetPrice(price float64) *EnterPosition {
+func (enterPosition *EnterPosition) SetPrice(price Decimal) *EnterPosition {
 	enterPosition.Price = &price
 	return enterPosition
 }
@@ -506,7 +511,7 @@ func (enterPosition *EnterPosition) SetPrice(price float64) *EnterPosition {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (enterPosition *EnterPosition) SetQuantity(quantity float64) *EnterPosition {
+func (enterPosition *EnterPosition) SetQuantity(quantity Decimal) *EnterPosition {
 	enterPosition.Quantity = &quantity
 	return enterPosition
 }
@@ -558,6 +563,11 @@ type EnterService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Enter], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех оприходований постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Enter, error]
+
 	// Create выполняет запрос на создание оприходования.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -622,6 +632,11 @@ type EnterService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[EnterPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*EnterPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -0,0 +1,655 @@
+// Code generated by go run ./internal/enumgen. DO NOT EDIT.
+
+package moysklad
+
+import "reflect"
+
+// knownEnumValues известные значения перечислений по типу перечисления.
+var knownEnumValues = map[reflect.Type]map[string]bool{
+	reflect.TypeFor[AccountCountry](): enumValues(
+		AccountCountryRU,
+		AccountCountryBY,
+		AccountCountryKZ,
+	),
+	reflect.TypeFor[AsyncState](): enumValues(
+		AsyncStatePending,
+		AsyncStateProcessing,
+		AsyncStateDone,
+		AsyncStateError,
+		AsyncStateCancel,
+		AsyncStateApiError,
+	),
+	reflect.TypeFor[AttributeType](): enumValues(
+		AttributeTypeTime,
+		AttributeTypeLink,
+		AttributeTypeString,
+		AttributeTypeText,
+		AttributeTypeFile,
+		AttributeTypeBoolean,
+		AttributeTypeDouble,
+		AttributeTypeLong,
+		AttributeTypeDictionaryContract,
+		AttributeTypeDictionaryCounterParty,
+		AttributeTypeDictionaryProject,
+		AttributeTypeDictionaryStore,
+		AttributeTypeDictionaryEmployee,
+		AttributeTypeDictionaryProduct,
+		AttributeTypeDictionaryCustom,
+	),
+	reflect.TypeFor[AuditEventType](): enumValues(
+		AuditEventRegistration,
+		AuditEventBulkOperation,
+		AuditEventClosePublication,
+		AuditEventCreate,
+		AuditEventDelete,
+		AuditEventOpenPublication,
+		AuditEventPrint,
+		AuditEventPutToArchive,
+		AuditEventPutToRecycleBin,
+		AuditEventReplaceToken,
+		AuditEventRestoreFromArchive,
+		AuditEventRestoreFromRecycleBin,
+		AuditEventSendEmailFromEntity,
+		AuditEventUpdate,
+	),
+	reflect.TypeFor[BarcodeType](): enumValues(
+		BarcodeEAN13,
+		BarcodeEAN8,
+		BarcodeCode128,
+		BarcodeGTIN,
+		BarcodeUPC,
+	),
+	reflect.TypeFor[BonusTransactionCategory](): enumValues(
+		BonusTransactionCategoryTypeRegular,
+		BonusTransactionCategoryTypeWelcome,
+	),
+	reflect.TypeFor[BonusTransactionStatus](): enumValues(
+		BonusTransactionStatusWaitProcessing,
+		BonusTransactionStatusCompleted,
+		BonusTransactionStatusCanceled,
+	),
+	reflect.TypeFor[BonusTransactionType](): enumValues(
+		Earning,
+		Spending,
+	),
+	reflect.TypeFor[CompanyType](): enumValues(
+		CompanyLegal,
+		CompanyEntrepreneur,
+		CompanyIndividual,
+	),
+	reflect.TypeFor[ContractType](): enumValues(
+		ContractTypeCommission,
+		ContractTypeSales,
+	),
+	reflect.TypeFor[CostDistributionType](): enumValues(
+		CostDistributionByPrice,
+		CostDistributionByProduction,
+	),
+	reflect.TypeFor[DefaultScreen](): enumValues(
+		DefaultScreenAudit,
+		DefaultScreenCurrency,
+		DefaultScreenEnrollOrder,
+		DefaultScreenCustomersBalanceList,
+		DefaultScreenRetailDrawerCashIn,
+		DefaultScreenInternalOrder,
+		DefaultScreenEnrollReturn,
+		DefaultScreenRetailSalesReturn,
+		DefaultScreenSalesReturn,
+		DefaultScreenPurchaseReturn,
+		DefaultScreenPrepaymentReturn,
+		DefaultScreenPurchaseFunnel,
+		DefaultScreenRetireOrder,
+		DefaultScreenCommissionReportOut,
+		DefaultScreenRetailDrawerCashOut,
+		DefaultScreenCashFlow,
+		DefaultScreenContract,
+		DefaultScreenOperation,
+		DefaultScreenUom,
+		DefaultScreenCRPTLog,
+		DefaultScreenLoyaltyLog,
+		DefaultScreenPurpose,
+		DefaultScreenCRPTDemand,
+		DefaultScreenProcessingOrder,
+		DefaultScreenCustomerOrder,
+		DefaultScreenPurchaseOrder,
+		DefaultScreenEvotorRequest,
+		DefaultScreenPhoneCall,
+		DefaultScreenCRPTPackageItemRemoval,
+		DefaultScreenImport,
+		DefaultScreenImportGoods,
+		DefaultScreenImportEdo,
+		DefaultScreenImportCustom,
+		DefaultScreenInventory,
+		DefaultScreenCompany,
+		DefaultScreenRecycleBin,
+		DefaultScreenAdjustment,
+		DefaultScreenBulkEdit,
+		DefaultScreenEvotorMapping,
+		DefaultScreenCompanySettings,
+		DefaultScreenFeed,
+		DefaultScreenTurnover,
+		DefaultScreenBonusTransaction,
+		DefaultScreenRemainsOrder,
+		DefaultScreenEnter,
+		DefaultScreenStockReport,
+		DefaultScreenDemand,
+		DefaultScreenCommissionReport,
+		DefaultScreenFiscalEvent,
+		DefaultScreenFiscalQueue,
+		DefaultScreenRemarkingOrder,
+		DefaultScreenMove,
+		DefaultScreenFinance,
+		DefaultScreenPayments,
+		DefaultScreenDashboard,
+		DefaultScreenCommissionReportIn,
+		DefaultScreenPriceList,
+		DefaultScreenPrepayment,
+		DefaultScreenPnl3,
+		DefaultScreenPnl,
+		DefaultScreenSupply,
+		DefaultScreenApps,
+		DefaultScreenEmbedApps,
+		DefaultScreenCheckEquipment,
+		DefaultScreenRetailDemand,
+		DefaultScreenProject,
+		DefaultScreenTrackingIdentify,
+		DefaultScreenCRPTPackageDisaggregation,
+		DefaultScreenOrderAssembly,
+		DefaultScreenSerialNumbers,
+		DefaultScreenConnectorSettings,
+		DefaultScreenDiscount,
+		DefaultScreenWarehouse,
+		DefaultScreenRetailShift,
+		DefaultScreenEvotorEvent,
+		DefaultScreenEmployee,
+		DefaultScreenSpecialOffers,
+		DefaultScreenCRPTCancellation,
+		DefaultScreenLoss,
+		DefaultScreenCountry,
+		DefaultScreenScriptTemplate,
+		DefaultScreenInvoiceOut,
+		DefaultScreenInvoiceIn,
+		DefaultScreenFactureOut,
+		DefaultScreenFactureIn,
+		DefaultScreenProcessingPlan,
+		DefaultScreenProcessing,
+		DefaultScreenGood,
+		DefaultScreenCommissionGoods,
+		DefaultScreenRetailStore,
+		DefaultScreenNotifications,
+		DefaultScreenPurchaseControl,
+		DefaultScreenAccount,
+		DefaultScreenCRPTPackageCreation,
+		DefaultScreenFeature,
+		DefaultScreenExport,
+		DefaultScreenMyCompany,
+		DefaultScreenHomePage,
+	),
+	reflect.TypeFor[DiscountStrategy](): enumValues(
+		DiscountStrategyBySum,
+		DiscountStrategyByPriority,
+	),
+	reflect.TypeFor[Distribution](): enumValues(
+		DistributionWeight,
+		DistributionVolume,
+		DistributionPrice,
+	),
+	reflect.TypeFor[EventType](): enumValues(
+		EventTypeAdd,
+		EventTypeModify,
+		EventTypeAddChangeStatus,
+	),
+	reflect.TypeFor[Extension](): enumValues(
+		XLS,
+		PDF,
+		HTML,
+		ODS,
+	),
+	reflect.TypeFor[FilterType](): enumValues(
+		FilterEquals,
+		FilterGreater,
+		FilterLesser,
+		FilterGreaterOrEquals,
+		FilterLesserOrEquals,
+		FilterNotEquals,
+		FilterEquivalence,
+		FilterEquivalenceLeft,
+		FilterEquivalenceRight,
+		FilterNotEquivalence,
+	),
+	reflect.TypeFor[FiscalType](): enumValues(
+		FiscalTypeStandard,
+		FiscalTypeMaster,
+		FiscalTypeCloud,
+	),
+	reflect.TypeFor[GroupBy](): enumValues(
+		GroupByProduct,
+		GroupByVariant,
+		GroupByConsignment,
+	),
+	reflect.TypeFor[Interval](): enumValues(
+		IntervalHour,
+		IntervalDay,
+		IntervalMonth,
+	),
+	reflect.TypeFor[Locale](): enumValues(
+		LocaleRU,
+		LocaleEN,
+	),
+	reflect.TypeFor[MRCType](): enumValues(
+		MRCTypeUserPrice,
+		MRCTypeMRCPrice,
+		MRCTypeSamePrice,
+	),
+	reflect.TypeFor[MarkingSellingMode](): enumValues(
+		MarkingSellingModeCorrectMarksOnly,
+		MarkingSellingModeWithoutErrors,
+		MarkingSellingModeAll,
+	),
+	reflect.TypeFor[MarksCheckMode](): enumValues(
+		MarksCheckModeCorrectMarksOnly,
+		MarksCheckModeWithoutErrors,
+		MarksCheckModeAll,
+	),
+	reflect.TypeFor[MetaType](): enumValues(
+		MetaTypeAccount,
+		MetaTypeAccumulationDiscount,
+		MetaTypeApplication,
+		MetaTypeAssortment,
+		MetaTypeAssortmentSettings,
+		MetaTypeAsync,
+		MetaTypeAttribute,
+		MetaTypeAudit,
+		MetaTypeAuditEvent,
+		MetaTypeBonusProgram,
+		MetaTypeBonusTransaction,
+		MetaTypeBundle,
+		MetaTypeBundleComponent,
+		MetaTypeCashier,
+		MetaTypeCashIn,
+		MetaTypeCashOut,
+		MetaTypeCharacteristic,
+		MetaTypeCommissionReportIn,
+		MetaTypeCommissionReportInPosition,
+		MetaTypeCommissionReportInReturnPosition,
+		MetaTypeCommissionReportOut,
+		MetaTypeCommissionReportOutPosition,
+		MetaTypeCompanySettings,
+		MetaTypeConsignment,
+		MetaTypeContactPerson,
+		MetaTypeContract,
+		MetaTypeCounterparty,
+		MetaTypeCounterpartyAdjustment,
+		MetaTypeCountry,
+		MetaTypeCurrency,
+		MetaTypeCustomerOrder,
+		MetaTypeCustomerOrderPosition,
+		MetaTypeCustomEntity,
+		MetaTypeCustomTemplate,
+		MetaTypeDemand,
+		MetaTypeDemandPosition,
+		MetaTypeDiscount,
+		MetaTypeEmbeddedTemplate,
+		MetaTypeEmployee,
+		MetaTypeEmployeeContext,
+		MetaTypeEnter,
+		MetaTypeEnterPosition,
+		MetaTypeExpenseItem,
+		MetaTypeFactureIn,
+		MetaTypeFactureOut,
+		MetaTypeFiles,
+		MetaTypeGroup,
+		MetaTypeImage,
+		MetaTypeInternalOrder,
+		MetaTypeInternalOrderPosition,
+		MetaTypeInventory,
+		MetaTypeInventoryPosition,
+		MetaTypeInvoiceIn,
+		MetaTypeInvoiceOut,
+		MetaTypeInvoicePosition,
+		MetaTypeLoss,
+		MetaTypeLossPosition,
+		MetaTypeNamedFilter,
+		MetaTypeMove,
+		MetaTypeMovePosition,
+		MetaTypeNote,
+		MetaTypeNotification,
+		MetaTypeNotificationExportCompleted,
+		MetaTypeNotificationImportCompleted,
+		MetaTypeNotificationGoodCountTooLow,
+		MetaTypeNotificationInvoiceOutOverdue,
+		MetaTypeNotificationOrderNew,
+		MetaTypeNotificationOrderOverdue,
+		MetaTypeNotificationSubscribeExpired,
+		MetaTypeNotificationSubscribeTermsExpired,
+		MetaTypeNotificationTaskAssigned,
+		MetaTypeNotificationTaskUnassigned,
+		MetaTypeNotificationTaskChanged,
+		MetaTypeNotificationTaskCompleted,
+		MetaTypeNotificationTaskDeleted,
+		MetaTypeNotificationTaskOverdue,
+		MetaTypeNotificationTaskReopened,
+		MetaTypeNotificationTaskNewComment,
+		MetaTypeNotificationTaskCommentChanged,
+		MetaTypeNotificationTaskCommentDeleted,
+		MetaTypeNotificationRetailShiftOpened,
+		MetaTypeNotificationRetailShiftClosed,
+		MetaTypeNotificationScript,
+		MetaTypeFacebookTokenExpirationNotification,
+		MetaTypeNotificationBonusMoney,
+		MetaTypeNewMentionInEvent,
+		MetaTypePublication,
+		MetaTypeOrganization,
+		MetaTypePaymentIn,
+		MetaTypePaymentOut,
+		MetaTypePersonalDiscount,
+		MetaTypePrepayment,
+		MetaTypePrepaymentPosition,
+		MetaTypePrepaymentReturn,
+		MetaTypePrepaymentReturnPosition,
+		MetaTypePriceList,
+		MetaTypePriceListPosition,
+		MetaTypePriceType,
+		MetaTypeProcessing,
+		MetaTypeProcessingOrder,
+		MetaTypeProcessingOrderPosition,
+		MetaTypeProcessingPlan,
+		MetaTypeProcessingPlanMaterial,
+		MetaTypeProcessingPlanProduct,
+		MetaTypeProcessingPositionMaterial,
+		MetaTypeProcessingPositionProduct,
+		MetaTypeProcessingProcess,
+		MetaTypeProcessingProcessPosition,
+		MetaTypeProcessingStage,
+		MetaTypeProduct,
+		MetaTypeProductFolder,
+		MetaTypeProject,
+		MetaTypePurchaseOrder,
+		MetaTypePurchaseOrderPosition,
+		MetaTypePurchaseReturn,
+		MetaTypePurchaseReturnPosition,
+		MetaTypeReceiptTemplate,
+		MetaTypeRegion,
+		MetaTypeRetailDemand,
+		MetaTypeRetailDemandPosition,
+		MetaTypeRetailDrawerCashIn,
+		MetaTypeRetailDrawerCashOut,
+		MetaTypeRetailSalesReturn,
+		MetaTypeRetailSalesReturnPosition,
+		MetaTypeRetailShift,
+		MetaTypeRetailStore,
+		MetaTypeSalesReturn,
+		MetaTypeSalesReturnPosition,
+		MetaTypeService,
+		MetaTypeSlot,
+		MetaTypeSpecialPriceDiscount,
+		MetaTypeState,
+		MetaTypeStore,
+		MetaTypeStoreZone,
+		MetaTypeSupply,
+		MetaTypeSupplyPosition,
+		MetaTypeTask,
+		MetaTypeTaskNote,
+		MetaTypeUom,
+		MetaTypeVariant,
+		MetaTypeWebhook,
+		MetaTypeCounterpartySettings,
+		MetaTypeRole,
+		MetaTypeSystemRole,
+		MetaTypeIndividualRole,
+		MetaTypeCustomRole,
+		MetaTypeUserSettings,
+		MetaTypeSubscription,
+		MetaTypeSalesChannel,
+		MetaTypeMetadata,
+		MetaTypeTaxRate,
+		MetaTypeThing,
+		MetaTypeToken,
+		MetaTypeReportStock,
+		MetaTypeReportStockByOperation,
+		MetaTypeReportStockByStore,
+		MetaTypeReportMoney,
+		MetaTypeReportMoneyPlotSeries,
+		MetaTypeReportProfitByCounterparty,
+		MetaTypeReportProfitByEmployee,
+		MetaTypeReportProfitByProduct,
+		MetaTypeReportProfitBySalesChannel,
+		MetaTypeReportProfitByVariant,
+		MetaTypeReportOrders,
+		MetaTypeReportSales,
+		MetaTypeReportTurnover,
+		MetaTypeReportDashboard,
+		MetaTypeReportCounterparty,
+		MetaTypeWebhookStock,
+		MetaTypeProcessingPlanFolder,
+		MetaTypeProductionTask,
+		MetaTypeProductionTaskMaterial,
+		MetaTypeProductionRow,
+		MetaTypeProductionTaskResult,
+		MetaTypeProductionStage,
+		MetaTypeProductionStageCompletion,
+		MetaTypeProductionStageCompletionMaterial,
+		MetaTypeProductionStageCompletionResult,
+		MetaTypeProcessingPlanStages,
+		MetaTypePayroll,
+		MetaTypeEntitySettings,
+		MetaTypeStateSettings,
+		MetaTypeTemplateSettings,
+		MetaTypeUnknown,
+	),
+	reflect.TypeFor[MinionToMaster](): enumValues(
+		MinionToMasterAny,
+		MinionToMasterSameGroup,
+		MinionToMasterChosen,
+	),
+	reflect.TypeFor[NotificationTaskState](): enumValues(
+		NotificationTaskStateCompleted,
+		NotificationTaskStateInterrupted,
+		NotificationTaskStateInterruptedByUser,
+		NotificationTaskStateInterruptedByTimeout,
+		NotificationTaskStateInterruptedBySystem,
+	),
+	reflect.TypeFor[NotificationTaskType](): enumValues(
+		NotificationTaskTypeExportCSVGood,
+		NotificationTaskTypeExportCSVAgent,
+		NotificationTaskTypeExportMSXML,
+		NotificationTaskTypeExport1CV2XML,
+		NotificationTaskTypeExportUnisender,
+		NotificationTaskTypeExport1CV3XML,
+		NotificationTaskTypeExportSubscribePro,
+		NotificationTaskTypeExport1CClientBank,
+		NotificationTaskTypeExportAlfaPayments,
+		NotificationTaskTypeExportTochkaPayments,
+		NotificationTaskTypeExportModulBankPayments,
+		NotificationTaskTypeExport1CEnterpriseData,
+		NotificationTaskTypeExportTinkoffPayments,
+		NotificationTaskTypeExportGood,
+		NotificationTaskTypeExportCustomEntity,
+		NotificationTaskTypeImportCVS,
+		NotificationTaskTypeImportYML,
+		NotificationTaskTypeImportCSVAgent,
+		NotificationTaskTypeImportCSVCustomerOrder,
+		NotificationTaskTypeImportCSVPurchaseOrder,
+		NotificationTaskTypeImportCSVPriceList,
+		NotificationTaskTypeImportMSXML,
+		NotificationTaskTypeImport1CClientBank,
+		NotificationTaskTypeImportAlfaPayments,
+		NotificationTaskTypeImportAlfaPaymentsRequest,
+		NotificationTaskTypeImportAlfaPaymentsSave,
+		NotificationTaskTypeImportTochkaPayments,
+		NotificationTaskTypeImportModulBankPayments,
+		NotificationTaskTypeImportTochkaPaymentsSave,
+		NotificationTaskTypeImportModulBankPaymentsSave,
+		NotificationTaskTypeImportTinkoffPayments,
+		NotificationTaskTypeImportTinkoffPaymentsSave,
+		NotificationTaskTypeImportGood,
+		NotificationTaskTypeImportGoodInDoc,
+		NotificationTaskTypeImportEDOSupply,
+		NotificationTaskTypeImportUnionCompany,
+		NotificationTaskTypeImportSberbankPaymentsRequest,
+		NotificationTaskTypeImportSberbankPaymentsSave,
+		NotificationTaskTypeImportUpdateVatTo20Percents,
+		NotificationTaskTypeImportCustomEntity,
+	),
+	reflect.TypeFor[OrderDirection](): enumValues(
+		OrderDirectionDefault,
+		OrderDirectionAsc,
+		OrderDirectionDesc,
+	),
+	reflect.TypeFor[PaymentItem](): enumValues(
+		PaymentItemGood,
+		PaymentItemExcisableGood,
+		PaymentItemCompoundPaymentItem,
+		PaymentItemAnotherPaymentItem,
+	),
+	reflect.TypeFor[PermissionValue](): enumValues(
+		PermissionOwn,
+		PermissionOwnShared,
+		PermissionOwnGroup,
+		PermissionOwnGroupShared,
+		PermissionAll,
+		PermissionNo,
+		PermissionNone,
+		ScriptPermissionValueNone,
+	),
+	reflect.TypeFor[PrintFormat](): enumValues(
+		PrintFormatPDF,
+		PrintFormatXLS,
+		PrintFormatODS,
+		PrintFormatDefault,
+		PrintFormatOpenInBrowser,
+	),
+	reflect.TypeFor[PriorityOFDSend](): enumValues(
+		PriorityOFDSendPhone,
+		PriorityOFDSendEmail,
+		PriorityOFDSendNone,
+	),
+	reflect.TypeFor[RateUpdateType](): enumValues(
+		RateUpdateTypeAuto,
+		RateUpdateTypeManual,
+	),
+	reflect.TypeFor[RewardType](): enumValues(
+		RewardTypePercentOfSales,
+		RewardTypeNone,
+	),
+	reflect.TypeFor[SalesChannelType](): enumValues(
+		SalesChannelTypeMessenger,
+		SalesChannelTypeSocialNetwork,
+		SalesChannelTypeMarketplace,
+		SalesChannelTypeEcommerce,
+		SalesChannelTypeClassifiedAds,
+		SalesChannelTypeDirectSales,
+		SalesChannelTypeOther,
+	),
+	reflect.TypeFor[SchemaDriftKind](): enumValues(
+		SchemaDriftUnknownField,
+		SchemaDriftUnknownValue,
+	),
+	reflect.TypeFor[ScriptPermissionValue](): enumValues(
+		ScriptPermissionValueOAuthorOrAssignee,
+		ScriptPermissionValueAssignee,
+		ScriptPermissionValueAuthor,
+		ScriptPermissionValueAll,
+		ScriptPermissionValueNo,
+	),
+	reflect.TypeFor[Sex](): enumValues(
+		Male,
+		Female,
+	),
+	reflect.TypeFor[StateType](): enumValues(
+		StateTypeRegular,
+		StateTypeSuccessful,
+		StateTypeUnsuccessful,
+	),
+	reflect.TypeFor[StockType](): enumValues(
+		StockDefault,
+		StockFreeStock,
+		StockQuantity,
+		StockReserve,
+		StockInTransit,
+	),
+	reflect.TypeFor[SubscriptionChannel](): enumValues(
+		SubscriptionChannelEmail,
+		SubscriptionChannelPush,
+	),
+	reflect.TypeFor[SubscriptionGroup](): enumValues(
+		SubscriptionGroupCustomerOrder,
+		SubscriptionGroupDataExchange,
+		SubscriptionGroupInvoice,
+		SubscriptionGroupRetail,
+		SubscriptionGroupScripts,
+		SubscriptionGroupStock,
+		SubscriptionGroupTask,
+		SubscriptionGroupMentions,
+	),
+	reflect.TypeFor[Tariff](): enumValues(
+		TariffBasic,
+		TariffCorporate,
+		TariffFree,
+		TariffMinimal,
+		TariffProfessional,
+		TariffRetail,
+		TariffStart,
+		TariffTrial,
+	),
+	reflect.TypeFor[TaxSystem](): enumValues(
+		TaxSystemGeneral,
+		TaxSystemSimplifiedIncome,
+		TaxSystemSimplifiedIncomeOutcome,
+		TaxSystemUnifiedAgricultural,
+		TaxSystemPresumptive,
+		TaxSystemPatentBased,
+		TaxSystemSameAsGroup,
+	),
+	reflect.TypeFor[TemplateType](): enumValues(
+		TemplateTypeEntity,
+		TemplateTypePriceType,
+		TemplateTypeMXTemplate,
+	),
+	reflect.TypeFor[TrackingCodeType](): enumValues(
+		TrackingCodeTypeTransportPack,
+		TrackingCodeTypeConsumerPack,
+		TrackingCodeTypeTrackingCode,
+	),
+	reflect.TypeFor[TrackingType](): enumValues(
+		TrackingTypeBeerAlcohol,
+		TrackingTypeElectronics,
+		TrackingTypeFoodSupplement,
+		TrackingTypeClothes,
+		TrackingTypeLinens,
+		TrackingTypeMedicalDevices,
+		TrackingTypeMilk,
+		TrackingTypeNcp,
+		TrackingTypeNotTracked,
+		TrackingTypeOtp,
+		TrackingTypePerfumery,
+		TrackingTypeSanitizer,
+		TrackingTypeShoes,
+		TrackingTypeSoftDrinks,
+		TrackingTypeTires,
+		TrackingTypeTobacco,
+		TrackingTypeWater,
+	),
+	reflect.TypeFor[UnitGender](): enumValues(
+		UnitGenderMasculine,
+		UnitGenderFeminine,
+	),
+	reflect.TypeFor[WebhookAction](): enumValues(
+		WebhookActionCreate,
+		WebhookActionUpdate,
+		WebhookActionDelete,
+		WebhookActionProcessed,
+	),
+	reflect.TypeFor[WebhookDiff](): enumValues(
+		WebhookDiffNone,
+		WebhookDiffFields,
+	),
+	reflect.TypeFor[WebhookReport](): enumValues(
+		WebhookReportAll,
+		WebhookReportByStore,
+	),
+	reflect.TypeFor[WelcomeBonusesMode](): enumValues(
+		WelcomeBonusesRegistration,
+		WelcomeBonusesFirstPurchase,
+	),
+}
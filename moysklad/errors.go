@@ -1,9 +1,59 @@
 package moysklad
 
-import "encoding/json"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Коды ошибок API МойСклад.
+//
+// [Документация МойСклад]
+//
+// [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/#mojsklad-json-api-obschie-swedeniq-obrabotka-oshibok
+const (
+	// Общие ошибки
+	ErrorCodeUnknown          = 1000 // Неизвестная ошибка
+	ErrorCodeUnknownPath      = 1002 // Неопознанный путь в URL
+	ErrorCodeMethodNotAllowed = 1005 // Метод не поддерживается
+	ErrorCodeAccessDenied     = 1016 // Недостаточно прав для выполнения операции
+	ErrorCodeEntityNotFound   = 1021 // Объект не найден
+	ErrorCodeRateLimit        = 1049 // Превышено ограничение на количество запросов за период
+	ErrorCodeAuthentication   = 1056 // Ошибка аутентификации: неверный логин, пароль или токен
+	ErrorCodeParallelLimit    = 1073 // Превышено ограничение на количество параллельных запросов
+
+	// Ошибки формата запроса
+	ErrorCodeJSONFormat           = 2000 // Ошибка формата JSON (коды 2000–2999 – ошибки разбора тела запроса: синтаксис, типы и значения полей)
+	errorCodeJSONFormatRangeLimit = 3000 // Граница диапазона кодов ошибок формата JSON
+
+	// Ошибки валидации
+	ErrorCodeValidation           = 3000 // Ошибка валидации (коды 3000–3999 – ошибки сохранения и валидации объектов)
+	errorCodeValidationRangeLimit = 4000 // Граница диапазона кодов ошибок валидации
+)
+
+// Ошибки, с которыми можно сравнивать ошибки API с помощью [errors.Is].
+//
+// # Пример:
+//
+//	_, _, err := client.Entity().Product().GetByID(ctx, id)
+//	if errors.Is(err, moysklad.ErrNotFound) {
+//		// товар удалён
+//	}
+var (
+	ErrNotFound           = errors.New("moysklad: entity not found")
+	ErrRateLimited        = errors.New("moysklad: rate limit exceeded")
+	ErrUnauthorized       = errors.New("moysklad: authentication failed")
+	ErrForbidden          = errors.New("moysklad: access denied")
+	ErrValidation         = errors.New("moysklad: validation failed")
+	ErrDependencyConflict = errors.New("moysklad: entity has dependencies")
+)
 
 // ApiError Структура ошибки API МойСклад.
 //
+// Поддерживает сравнение с ошибками [ErrNotFound], [ErrRateLimited], [ErrUnauthorized],
+// [ErrForbidden], [ErrValidation] и [ErrDependencyConflict] с помощью [errors.Is].
+//
 // [Документация МойСклад]
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/#mojsklad-json-api-obschie-swedeniq-obrabotka-oshibok
@@ -17,20 +67,248 @@ type ApiError struct {
 	Code         int         `json:"code,omitempty"`          // Код ошибки (Если поле ничего не содержит, смотрите HTTP status cod
 	Line         int         `json:"line,omitempty"`          // Строка JSON, на которой произошла ошибка
 	Column       int         `json:"column,omitempty"`        // Координата элемента в строке line, на котором произошла ошибка
+	StatusCode   int         `json:"-"`                       // HTTP-статус ответа, в котором получена ошибка
 }
 
-// Error выводит ошибку в формате JSON
+// Error возвращает описание ошибки в виде
+// «moysklad: 400 [3000] заголовок: сообщение (parameter: name, line: 1, column: 2)».
 func (apiError ApiError) Error() string {
-	b, _ := json.Marshal(apiError)
-	return string(b)
+	var sb strings.Builder
+	sb.WriteString("moysklad:")
+
+	if apiError.StatusCode != 0 {
+		fmt.Fprintf(&sb, " %d", apiError.StatusCode)
+	}
+
+	if apiError.Code != 0 {
+		fmt.Fprintf(&sb, " [%d]", apiError.Code)
+	}
+
+	if apiError.Header != "" {
+		sb.WriteString(" " + apiError.Header)
+	}
+
+	if apiError.Message != "" && apiError.Message != apiError.Header {
+		if apiError.Header != "" {
+			sb.WriteString(":")
+		}
+		sb.WriteString(" " + apiError.Message)
+	}
+
+	var details []string
+	if apiError.Parameter != "" {
+		details = append(details, "parameter: "+apiError.Parameter)
+	}
+	if apiError.Line != 0 {
+		details = append(details, fmt.Sprintf("line: %d", apiError.Line))
+	}
+	if apiError.Column != 0 {
+		details = append(details, fmt.Sprintf("column: %d", apiError.Column))
+	}
+	if len(apiError.Dependencies) > 0 {
+		details = append(details, fmt.Sprintf("dependencies: %d", len(apiError.Dependencies)))
+	}
+	if len(details) > 0 {
+		sb.WriteString(" (" + strings.Join(details, ", ") + ")")
+	}
+
+	return sb.String()
+}
+
+// Is реализует сравнение с ошибками [ErrNotFound], [ErrRateLimited], [ErrUnauthorized],
+// [ErrForbidden], [ErrValidation] и [ErrDependencyConflict] для [errors.Is].
+//
+// Если код ошибки не указан, ошибка классифицируется по HTTP-статусу ответа.
+func (apiError ApiError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return apiError.Code == ErrorCodeEntityNotFound || apiError.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return apiError.Code == ErrorCodeRateLimit || apiError.Code == ErrorCodeParallelLimit ||
+			apiError.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return apiError.Code == ErrorCodeAuthentication || apiError.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return apiError.Code == ErrorCodeAccessDenied || apiError.StatusCode == http.StatusForbidden
+	case ErrValidation:
+		return apiError.Code >= ErrorCodeValidation && apiError.Code < errorCodeValidationRangeLimit
+	case ErrDependencyConflict:
+		return len(apiError.Dependencies) > 0
+	}
+	return false
+}
+
+// IsJSONFormat возвращает «true», если ошибка относится к разбору тела запроса (коды 2000–2999).
+func (apiError ApiError) IsJSONFormat() bool {
+	return apiError.Code >= ErrorCodeJSONFormat && apiError.Code < errorCodeJSONFormatRangeLimit
 }
 
 // ApiErrors Структура ошибок API МойСклад.
+//
+// Для получения отдельной ошибки следует использовать [errors.As] с целевым типом *[ApiError].
+//
+// # Пример:
+//
+//	var apiError *moysklad.ApiError
+//	if errors.As(err, &apiError) {
+//		fmt.Println(apiError.Code, apiError.Parameter)
+//	}
 type ApiErrors struct {
-	ApiErrors Slice[ApiError] `json:"errors"` // Список ошибок
+	ApiErrors  Slice[ApiError] `json:"errors"` // Список ошибок
+	StatusCode int             `json:"-"`      // HTTP-статус ответа, в котором получены ошибки
 }
 
+// Error возвращает описания всех ошибок, разделённые символом «;».
 func (apiErrors ApiErrors) Error() string {
-	b, _ := json.Marshal(apiErrors)
-	return string(b)
+	messages := make([]string, 0, len(apiErrors.ApiErrors))
+	for _, apiError := range apiErrors.ApiErrors {
+		if apiError != nil {
+			messages = append(messages, apiError.Error())
+		}
+	}
+
+	if len(messages) == 0 {
+		return fmt.Sprintf("moysklad: %d %s", apiErrors.StatusCode, http.StatusText(apiErrors.StatusCode))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap возвращает список ошибок для [errors.Is] и [errors.As].
+func (apiErrors ApiErrors) Unwrap() []error {
+	errs := make([]error, 0, len(apiErrors.ApiErrors))
+	for _, apiError := range apiErrors.ApiErrors {
+		if apiError != nil {
+			errs = append(errs, apiError)
+		}
+	}
+	return errs
+}
+
+// Is реализует сравнение с ошибками [ErrNotFound], [ErrRateLimited] и другими для [errors.Is]
+// в случае, когда ответ не содержит ошибок API и ошибка классифицируется по HTTP-статусу.
+func (apiErrors ApiErrors) Is(target error) bool {
+	if len(apiErrors.ApiErrors) > 0 {
+		return false
+	}
+	return ApiError{StatusCode: apiErrors.StatusCode}.Is(target)
+}
+
+// Codes возвращает коды всех ошибок.
+func (apiErrors ApiErrors) Codes() []int {
+	var codes []int
+	for _, apiError := range apiErrors.ApiErrors {
+		if apiError != nil && apiError.Code != 0 {
+			codes = append(codes, apiError.Code)
+		}
+	}
+	return codes
+}
+
+// setStatusCode устанавливает HTTP-статус ответа для всех ошибок.
+func (apiErrors *ApiErrors) setStatusCode(statusCode int) {
+	apiErrors.StatusCode = statusCode
+	for _, apiError := range apiErrors.ApiErrors {
+		if apiError != nil {
+			apiError.StatusCode = statusCode
+		}
+	}
+}
+
+// IsNotFound возвращает «true», если err содержит ошибку API «объект не найден».
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsRateLimited возвращает «true», если err содержит ошибку превышения ограничений на количество запросов.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsUnauthorized возвращает «true», если err содержит ошибку аутентификации.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbidden возвращает «true», если err содержит ошибку недостаточности прав
+// (код [ErrorCodeAccessDenied] или HTTP-статус 403).
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsValidation возвращает «true», если err содержит ошибку валидации объекта.
+func IsValidation(err error) bool {
+	return errors.Is(err, ErrValidation)
+}
+
+// IsDependencyConflict возвращает «true», если err содержит ошибку удаления объекта,
+// на который ссылаются другие сущности или документы (см. поле Dependencies).
+func IsDependencyConflict(err error) bool {
+	return errors.Is(err, ErrDependencyConflict)
+}
+
+// PageError ошибка получения страницы списка или выполнения порции массовой операции.
+type PageError struct {
+	Err    error // Ошибка запроса страницы
+	Offset int   // Смещение страницы; для порции массовой операции – индекс первого объекта порции
+}
+
+// Error реализует интерфейс error.
+func (pageError *PageError) Error() string {
+	return fmt.Sprintf("offset %d: %v", pageError.Offset, pageError.Err)
+}
+
+// Unwrap возвращает ошибку запроса страницы.
+func (pageError *PageError) Unwrap() error {
+	return pageError.Err
+}
+
+// PagesError ошибка получения части страниц при запросе всех объектов списка (например, GetListAll)
+// или выполнения части порций массового создания, изменения и удаления (CreateUpdateMany, DeleteMany).
+//
+// Вместе с ошибкой возвращаются объекты успешно полученных страниц (выполненных порций),
+// поэтому результат может быть неполным.
+type PagesError struct {
+	Pages   []*PageError // Ошибки страниц в порядке смещения
+	Skipped []int        // Смещения страниц, которые не запрашивались из-за остановки после первой ошибки или отмены контекста
+	Total   int          // Общее количество страниц
+}
+
+// Error реализует интерфейс error.
+func (pagesError *PagesError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "moysklad: %d of %d pages failed", len(pagesError.Pages), pagesError.Total)
+
+	if len(pagesError.Skipped) > 0 {
+		fmt.Fprintf(&sb, " (%d skipped)", len(pagesError.Skipped))
+	}
+
+	for i, pageError := range pagesError.Pages {
+		if i == 0 {
+			sb.WriteString(": ")
+		} else {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(pageError.Error())
+	}
+
+	return sb.String()
+}
+
+// Unwrap возвращает ошибки страниц для [errors.Is] и [errors.As].
+func (pagesError *PagesError) Unwrap() []error {
+	errs := make([]error, len(pagesError.Pages))
+	for i, pageError := range pagesError.Pages {
+		errs[i] = pageError
+	}
+	return errs
+}
+
+// Offsets возвращает смещения страниц, которые не удалось получить.
+func (pagesError *PagesError) Offsets() []int {
+	offsets := make([]int, len(pagesError.Pages))
+	for i, pageError := range pagesError.Pages {
+		offsets[i] = pageError.Offset
+	}
+	return offsets
 }
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -23,6 +24,8 @@ type ExpenseItem struct {
 	Meta         *Meta      `json:"meta,omitempty"`         // Метаданные о Статье расходов
 	Name         *string    `json:"name,omitempty"`         // Наименование Статьи расходов
 	Updated      *Timestamp `json:"updated,omitempty"`      // Момент последнего обновления сущности
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -142,6 +145,11 @@ type ExpenseItemService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[ExpenseItem], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех статей расходов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ExpenseItem, error]
+
 	// Create выполняет запрос на создание статьи расходов.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование Статьи расходов)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -38,13 +39,15 @@ type FactureIn struct {
 	Rate           *NullValue[Rate]     `json:"rate,omitempty"`           // Валюта
 	Shared         *bool                `json:"shared,omitempty"`         // Общий доступ
 	State          *NullValue[State]    `json:"state,omitempty"`          // Метаданные статуса полученного счета-фактуры
-	Sum            *float64             `json:"sum,omitempty"`            // Сумма полученного счета-фактуры в установленной валюте
+	Sum            *Decimal             `json:"sum,omitempty"`            // Сумма полученного счета-фактуры в установленной валюте
 	SyncID         *string              `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp           `json:"updated,omitempty"`        // Момент последнего обновления полученного счета-фактуры
 	Supplies       Slice[Supply]        `json:"supplies,omitempty"`       // Массив ссылок на связанные приемки
 	Payments       Slice[Payment]       `json:"payments,omitempty"`       // Массив ссылок на связанные исходящие платежи
 	IncomingNumber *string              `json:"incomingNumber,omitempty"` // Входящий номер
 	Attributes     Slice[Attribute]     `json:"attributes,omitempty"`     // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -183,7 +186,7 @@ func (factureIn FactureIn) GetState() State {
 }
 
 // GetSum возвращает Сумму полученного счета-фактуры в установленной валюте.
-func (factureIn FactureIn) GetSum() float64 {
+func (factureIn FactureIn) GetSum() Decimal {
 	return Deref(factureIn.Sum)
 }
 
@@ -410,6 +413,11 @@ type FactureInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[FactureIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех полученных счетов-фактур постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*FactureIn, error]
+
 	// Create выполняет запрос на создание полученного счета-фактуры.
 	// Обязательные поля для заполнения:
 	//	- incomingNumber (Входящий номер)
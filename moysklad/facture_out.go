@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -39,7 +40,7 @@ type FactureOut struct {
 	Shared          *bool                 `json:"shared,omitempty"`          // Общий доступ
 	State           *NullValue[State]     `json:"state,omitempty"`           // Метаданные статуса выданного Счета-фактуры
 	StateContractID *string               `json:"stateContractId,omitempty"` // Идентификатор государственного контракта, договора (соглашения)
-	Sum             *float64              `json:"sum,omitempty"`             // Сумма выданного Счета-фактуры в копейках
+	Sum             *Decimal              `json:"sum,omitempty"`             // Сумма выданного Счета-фактуры в копейках
 	SyncID          *string               `json:"syncId,omitempty"`          // ID синхронизации
 	Updated         *Timestamp            `json:"updated,omitempty"`         // Момент последнего обновления выданного Счета-фактуры
 	Demands         Slice[Demand]         `json:"demands,omitempty"`         // Массив ссылок на связанные отгрузки
@@ -48,6 +49,8 @@ type FactureOut struct {
 	Consignee       *Agent                `json:"consignee,omitempty"`       // Метаданные грузополучателя (контрагент или юрлицо)
 	PaymentNumber   *string               `json:"paymentNumber,omitempty"`   // Название платежного документа
 	Attributes      Slice[Attribute]      `json:"attributes,omitempty"`      // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -191,7 +194,7 @@ func (factureOut FactureOut) GetStateContractID() string {
 }
 
 // GetSum возвращает Сумму выданного Счета-фактуры в копейках.
-func (factureOut FactureOut) GetSum() float64 {
+func (factureOut FactureOut) GetSum() Decimal {
 	return Deref(factureOut.Sum)
 }
 
@@ -452,6 +455,11 @@ type FactureOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[FactureOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех выданных счетов-фактур постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*FactureOut, error]
+
 	// Create выполняет запрос на создание выданного счета-фактуры.
 	// Обязательные поля для заполнения:
 	//	- paymentNumber (Название платежного документа)
@@ -19,6 +19,8 @@ type File struct {
 	Size      *int       `json:"size,omitempty"`      // Размер Файла в байтах
 	Tiny      *Meta      `json:"tiny,omitempty"`      // Метаданные уменьшенного изображения (поле передается только для Файлов изображений)
 	Title     *string    `json:"title,omitempty"`     // Название Файла
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetCreated возвращает Время загрузки Файла на сервер.
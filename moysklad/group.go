@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Group Отдел.
@@ -18,6 +19,8 @@ type Group struct {
 	Index     *int    `json:"index,omitempty"`     // Порядковый номер в списке отделов
 	Meta      *Meta   `json:"meta,omitempty"`      // Метаданные Отдела
 	Name      *string `json:"name,omitempty"`      // Наименование Отдела
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -110,6 +113,11 @@ type GroupService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Group], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех отделов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Group, error]
+
 	// Create выполняет запрос на создание отдела.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование отдела)
@@ -5,7 +5,6 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"reflect"
 
@@ -78,10 +77,21 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 			return
 		}
 
+		// special handling of Decimal values
+		if v.Type() == reflect.TypeOf(Decimal{}) {
+			fmt.Fprintf(w, "{%s}", v.Interface())
+			return
+		}
+
 		w.Write([]byte{'{'})
 
 		var sep bool
 		for i := 0; i < v.NumField(); i++ {
+			// служебные поля (сырые данные, неизвестные поля JSON) не выводятся
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+
 			fv := v.Field(i)
 			if fv.Kind() == reflect.Ptr && fv.IsNil() {
 				continue
@@ -189,12 +199,10 @@ func UnmarshallAny[T any](data any) (T, error) {
 	var t T
 	b, err := json.Marshal(data)
 	if err != nil {
-		log.Println(err)
 		return t, err
 	}
 
 	if err = json.Unmarshal(b, &t); err != nil {
-		log.Println(err)
 		return t, err
 	}
 
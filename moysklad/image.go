@@ -18,6 +18,8 @@ type Image struct {
 	Tiny      *Meta      `json:"tiny,omitempty"`      // Метаданные уменьшенного изображения
 	Title     *string    `json:"title,omitempty"`     // Название Изображения
 	Updated   *Timestamp `json:"updated,omitempty"`   // Время загрузки файла на сервер
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetContent возвращает изображение, закодированное в Base64.
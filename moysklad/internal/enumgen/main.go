@@ -0,0 +1,138 @@
+// Command enumgen генерирует реестр известных значений перечислений пакета moysklad.
+//
+// Перечислением считается экспортируемый тип с базовым типом string, для которого объявлены
+// константы с явным указанием типа. Реестр используется для обнаружения новых значений в ответах API.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("enumgen: ")
+
+	src := flag.String("src", ".", "каталог пакета moysklad")
+	out := flag.String("out", "enums_gen.go", "файл с реестром")
+	flag.Parse()
+
+	enums, err := load(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := generate(enums)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load возвращает названия констант по названию типа перечисления.
+func load(dir string) (map[string][]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	stringTypes := make(map[string]bool)
+	constants := make(map[string][]string)
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if ident, ok := spec.Type.(*ast.Ident); ok && ident.Name == "string" && spec.Name.IsExported() && spec.Assign == 0 {
+						stringTypes[spec.Name.Name] = true
+					}
+
+				case *ast.ValueSpec:
+					typ, ok := spec.Type.(*ast.Ident)
+					if gen.Tok != token.CONST || !ok {
+						continue
+					}
+					for _, ident := range spec.Names {
+						if ident.IsExported() {
+							constants[typ.Name] = append(constants[typ.Name], ident.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	enums := make(map[string][]string)
+	for typ, names := range constants {
+		if stringTypes[typ] {
+			enums[typ] = names
+		}
+	}
+
+	if len(enums) == 0 {
+		return nil, fmt.Errorf("в каталоге %s нет перечислений", dir)
+	}
+
+	return enums, nil
+}
+
+// generate возвращает отформатированный исходный код реестра.
+func generate(enums map[string][]string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by go run ./internal/enumgen. DO NOT EDIT.\n\n")
+	buf.WriteString("package moysklad\n\nimport \"reflect\"\n\n")
+	buf.WriteString("// knownEnumValues известные значения перечислений по типу перечисления.\n")
+	buf.WriteString("var knownEnumValues = map[reflect.Type]map[string]bool{\n")
+
+	types := make([]string, 0, len(enums))
+	for typ := range enums {
+		types = append(types, typ)
+	}
+	slices.Sort(types)
+
+	for _, typ := range types {
+		fmt.Fprintf(&buf, "reflect.TypeFor[%s](): enumValues(\n", typ)
+		for _, name := range enums[typ] {
+			fmt.Fprintf(&buf, "%s,\n", name)
+		}
+		buf.WriteString("),\n")
+	}
+	buf.WriteString("}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("форматирование: %w", err)
+	}
+	return source, nil
+}
@@ -0,0 +1,191 @@
+// Command unknowngen генерирует методы UnmarshalJSON и MarshalJSON для структур пакета moysklad,
+// сохраняющих неизвестные поля.
+//
+// Методы создаются для каждой структуры, в которую встроен тип unknownFields.
+// Метод не создаётся, если структура уже объявляет его вне генерируемого файла.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// embedded название встраиваемого типа, хранящего неизвестные поля.
+const embedded = "unknownFields"
+
+// structType структура, сохраняющая неизвестные поля.
+type structType struct {
+	name     string
+	receiver string          // название получателя в объявленных методах структуры
+	methods  map[string]bool // объявленные методы структуры
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("unknowngen: ")
+
+	src := flag.String("src", ".", "каталог пакета moysklad")
+	out := flag.String("out", "unknown_fields_gen.go", "файл с методами")
+	flag.Parse()
+
+	types, err := load(*src, filepath.Base(*out))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := generate(types)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load возвращает структуры, сохраняющие неизвестные поля, упорядоченные по названию.
+// Файл skip (генерируемый) не учитывается.
+func load(dir, skip string) ([]*structType, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := make(map[string]*structType)
+	receivers := make(map[string]string)
+	methods := make(map[string]map[string]bool)
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == skip || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok && embeds(spec) {
+						structs[spec.Name.Name] = &structType{name: spec.Name.Name}
+					}
+				}
+
+			case *ast.FuncDecl:
+				typ, receiver, ok := receiverOf(decl)
+				if !ok {
+					continue
+				}
+				if receiver != "" && receiver != "_" {
+					receivers[typ] = receiver
+				}
+				if methods[typ] == nil {
+					methods[typ] = make(map[string]bool)
+				}
+				methods[typ][decl.Name.Name] = true
+			}
+		}
+	}
+
+	if len(structs) == 0 {
+		return nil, fmt.Errorf("в каталоге %s нет структур со встроенным типом %s", dir, embedded)
+	}
+
+	types := make([]*structType, 0, len(structs))
+	for name, typ := range structs {
+		typ.receiver = receivers[name]
+		if typ.receiver == "" {
+			r, size := utf8.DecodeRuneInString(name)
+			typ.receiver = string(unicode.ToLower(r)) + name[size:]
+		}
+		typ.methods = methods[name]
+		types = append(types, typ)
+	}
+	slices.SortFunc(types, func(a, b *structType) int { return strings.Compare(a.name, b.name) })
+
+	return types, nil
+}
+
+// embeds возвращает true, если spec объявляет структуру со встроенным типом unknownFields.
+func embeds(spec *ast.TypeSpec) bool {
+	structType, ok := spec.Type.(*ast.StructType)
+	if !ok || spec.TypeParams != nil {
+		return false
+	}
+
+	for _, field := range structType.Fields.List {
+		if ident, ok := field.Type.(*ast.Ident); ok && len(field.Names) == 0 && ident.Name == embedded {
+			return true
+		}
+	}
+	return false
+}
+
+// receiverOf возвращает название типа и название получателя метода decl.
+func receiverOf(decl *ast.FuncDecl) (string, string, bool) {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return "", "", false
+	}
+
+	field := decl.Recv.List[0]
+	expr := field.Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+
+	var receiver string
+	if len(field.Names) > 0 {
+		receiver = field.Names[0].Name
+	}
+	return ident.Name, receiver, true
+}
+
+// generate возвращает отформатированный исходный код методов.
+func generate(types []*structType) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by go run ./internal/unknowngen. DO NOT EDIT.\n\n")
+	buf.WriteString("package moysklad\n\n")
+
+	for _, typ := range types {
+		if !typ.methods["UnmarshalJSON"] {
+			fmt.Fprintf(&buf, "// UnmarshalJSON реализует интерфейс [json.Unmarshaler].\n")
+			fmt.Fprintf(&buf, "func (%[1]s *%[2]s) UnmarshalJSON(data []byte) error {\n", typ.receiver, typ.name)
+			fmt.Fprintf(&buf, "return unmarshalUnknownFields(data, %[1]s, &%[1]s.unknownFields)\n}\n\n", typ.receiver)
+		}
+
+		if !typ.methods["MarshalJSON"] {
+			fmt.Fprintf(&buf, "// MarshalJSON реализует интерфейс [json.Marshaler].\n")
+			fmt.Fprintf(&buf, "func (%[1]s %[2]s) MarshalJSON() ([]byte, error) {\n", typ.receiver, typ.name)
+			fmt.Fprintf(&buf, "type alias %s\n", typ.name)
+			fmt.Fprintf(&buf, "return marshalUnknownFields(alias(%[1]s), %[1]s.unknownFields)\n}\n\n", typ.receiver)
+		}
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("форматирование: %w", err)
+	}
+	return source, nil
+}
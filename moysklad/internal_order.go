@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -17,7 +18,7 @@ import (
 type InternalOrder struct {
 	Organization          *Organization                     `json:"organization,omitempty"`          // Метаданные юрлица
 	Description           *string                           `json:"description,omitempty"`           // Комментарий Внутреннего заказа
-	VatSum                *float64                          `json:"vatSum,omitempty"`                // Сумма НДС
+	VatSum                *Decimal                          `json:"vatSum,omitempty"`                // Сумма НДС
 	AccountID             *string                           `json:"accountId,omitempty"`             // ID учётной записи
 	Created               *Timestamp                        `json:"created,omitempty"`               // Дата создания
 	Deleted               *Timestamp                        `json:"deleted,omitempty"`               // Момент последнего удаления Внутреннего заказа
@@ -42,12 +43,14 @@ type InternalOrder struct {
 	Shared                *bool                             `json:"shared,omitempty"`                // Общий доступ
 	State                 *NullValue[State]                 `json:"state,omitempty"`                 // Метаданные статуса Внутреннего заказа
 	Store                 *NullValue[Store]                 `json:"store,omitempty"`                 // Метаданные склада
-	Sum                   *float64                          `json:"sum,omitempty"`                   // Сумма Внутреннего заказа в копейках
+	Sum                   *Decimal                          `json:"sum,omitempty"`                   // Сумма Внутреннего заказа в копейках
 	SyncID                *string                           `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                        `json:"updated,omitempty"`               // Момент последнего обновления Внутреннего заказа
 	VatEnabled            *bool                             `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
 	VatIncluded           *bool                             `json:"vatIncluded,omitempty"`           // Включен ли НДС в цену
 	Attributes            Slice[Attribute]                  `json:"attributes,omitempty"`            // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -76,7 +79,7 @@ func (internalOrder InternalOrder) GetDescription() string {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (internalOrder InternalOrder) GetVatSum() float64 {
+func (internalOrder InternalOrder) GetVatSum() Decimal {
 	return Deref(internalOrder.VatSum)
 }
 
@@ -201,7 +204,7 @@ func (internalOrder InternalOrder) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Внутреннего заказа в копейках.
-func (internalOrder InternalOrder) GetSum() float64 {
+func (internalOrder InternalOrder) GetSum() Decimal {
 	return Deref(internalOrder.Sum)
 }
 
@@ -435,10 +438,12 @@ type InternalOrderPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -462,12 +467,12 @@ func (internalOrderPosition InternalOrderPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (internalOrderPosition InternalOrderPosition) GetPrice() float64 {
+func (internalOrderPosition InternalOrderPosition) GetPrice() Decimal {
 	return Deref(internalOrderPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (internalOrderPosition InternalOrderPosition) GetQuantity() float64 {
+func (internalOrderPosition InternalOrderPosition) GetQuantity() Decimal {
 	return Deref(internalOrderPosition.Quantity)
 }
 
@@ -500,13 +505,13 @@ func (internalOrderPosition *InternalOrderPosition) SetPack(pack *Pack) *Interna
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (internalOrderPosition *InternalOrderPosition) SetPrice(price float64) *InternalOrderPosition {
+func (internalOrderPosition *InternalOrderPosition) SetPrice(price Decimal) *InternalOrderPosition {
 	internalOrderPosition.Price = &price
 	return internalOrderPosition
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (internalOrderPosition *InternalOrderPosition) SetQuantity(quantity float64) *InternalOrderPosition {
+func (internalOrderPosition *InternalOrderPosition) SetQuantity(quantity Decimal) *InternalOrderPosition {
 	internalOrderPosition.Quantity = &quantity
 	return internalOrderPosition
 }
@@ -545,6 +550,11 @@ type InternalOrderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[InternalOrder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех внутренних заказов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*InternalOrder, error]
+
 	// Create выполняет запрос на создание внутреннего заказа.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -600,6 +610,11 @@ type InternalOrderService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InternalOrderPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InternalOrderPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"net/http"
 	"time"
@@ -18,7 +19,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-inwentarizaciq
 type Inventory struct {
 	Name         *string                       `json:"name,omitempty"`         // Наименование Инвентаризации
-	Sum          *float64                      `json:"sum,omitempty"`          // Сумма Инвентаризации в копейках
+	Sum          *Decimal                      `json:"sum,omitempty"`          // Сумма Инвентаризации в копейках
 	Code         *string                       `json:"code,omitempty"`         // Код Инвентаризации
 	Created      *Timestamp                    `json:"created,omitempty"`      // Дата создания
 	Deleted      *Timestamp                    `json:"deleted,omitempty"`      // Момент последнего удаления Инвентаризации
@@ -43,6 +44,8 @@ type Inventory struct {
 	Attributes   Slice[Attribute]              `json:"attributes,omitempty"`   // Список метаданных доп. полей
 	Enters       Slice[Enter]                  `json:"enters,omitempty"`       // Список связанных с инвентаризацией оприходований
 	Losses       Slice[Loss]                   `json:"losses,omitempty"`       // Список связанных с инвентаризацией списаний
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -66,7 +69,7 @@ func (inventory Inventory) GetName() string {
 }
 
 // GetSum возвращает Сумму Инвентаризации в копейках.
-func (inventory Inventory) GetSum() float64 {
+func (inventory Inventory) GetSum() Decimal {
 	return Deref(inventory.Sum)
 }
 
@@ -353,13 +356,15 @@ func (inventory *Inventory) Delete(ctx context.Context, client *Client) (bool, *
 type InventoryPosition struct {
 	AccountID          *string             `json:"accountId,omitempty"`          // ID учётной записи
 	Assortment         *AssortmentPosition `json:"assortment,omitempty"`         // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
-	CalculatedQuantity *float64            `json:"calculatedQuantity,omitempty"` // расчетный остаток
-	CorrectionAmount   *float64            `json:"correctionAmount,omitempty"`   // разница между расчетным остатком и фактическим
-	CorrectionSum      *float64            `json:"correctionSum,omitempty"`      // избыток/недостача
+	CalculatedQuantity *Decimal            `json:"calculatedQuantity,omitempty"` // расчетный остаток
+	CorrectionAmount   *Decimal            `json:"correctionAmount,omitempty"`   // разница между расчетным остатком и фактическим
+	CorrectionSum      *Decimal            `json:"correctionSum,omitempty"`      // избыток/недостача
 	ID                 *string             `json:"id,omitempty"`                 // ID сущности
 	Pack               *Pack               `json:"pack,omitempty"`               // Упаковка Товара
-	Price              *float64            `json:"price,omitempty"`              // Цена товара/услуги в копейках
-	Quantity           *float64            `json:"quantity,omitempty"`           // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price              *Decimal            `json:"price,omitempty"`              // Цена товара/услуги в копейках
+	Quantity           *Decimal            `json:"quantity,omitempty"`           // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -373,17 +378,17 @@ func (inventoryPosition InventoryPosition) GetAssortment() AssortmentPosition {
 }
 
 // GetCalculatedQuantity возвращает расчетный остаток.
-func (inventoryPosition InventoryPosition) GetCalculatedQuantity() float64 {
+func (inventoryPosition InventoryPosition) GetCalculatedQuantity() Decimal {
 	return Deref(inventoryPosition.CalculatedQuantity)
 }
 
 // GetCorrectionAmount возвращает разницу между расчетным остатком и фактическим.
-func (inventoryPosition InventoryPosition) GetCorrectionAmount() float64 {
+func (inventoryPosition InventoryPosition) GetCorrectionAmount() Decimal {
 	return Deref(inventoryPosition.CorrectionAmount)
 }
 
 // GetCorrectionSum возвращает избыток/недостачу
-func (inventoryPosition InventoryPosition) GetCorrectionSum() float64 {
+func (inventoryPosition InventoryPosition) GetCorrectionSum() Decimal {
 	return Deref(inventoryPosition.CorrectionSum)
 }
 
@@ -398,7 +403,7 @@ func (inventoryPosition InventoryPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (inventoryPosition InventoryPosition) GetPrice() float64 {
+func (inventoryPosition InventoryPosition) GetPrice() Decimal {
 	return Deref(inventoryPosition.Price)
 }
 
@@ -406,7 +411,7 @@ func (inventoryPosition InventoryPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (inventoryPosition InventoryPosition) GetQuantity() float64 {
+func (inventoryPosition InventoryPosition) GetQuantity() Decimal {
 	return Deref(inventoryPosition.Quantity)
 }
 
@@ -421,7 +426,7 @@ func (inventoryPosition *InventoryPosition) SetAssortment(assortment AssortmentC
 }
 
 // SetCalculatedQuantity устанавливает расчетный остаток.
-func (inventoryPosition *InventoryPosition) SetCalculatedQuantity(calculatedQuantity float64) *InventoryPosition {
+func (inventoryPosition *InventoryPosition) SetCalculatedQuantity(calculatedQuantity Decimal) *InventoryPosition {
 	inventoryPosition.CalculatedQuantity = &calculatedQuantity
 	return inventoryPosition
 }
@@ -435,7 +440,7 @@ func (inventoryPosition *InventoryPosition) SetPack(pack *Pack) *InventoryPositi
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (inventoryPosition *InventoryPosition) SetPrice(price float64) *InventoryPosition {
+func (inventoryPosition *InventoryPosition) SetPrice(price Decimal) *InventoryPosition {
 	inventoryPosition.Price = &price
 	return inventoryPosition
 }
@@ -444,7 +449,7 @@ func (inventoryPosition *InventoryPosition) SetPrice(price float64) *InventoryPo
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (inventoryPosition *InventoryPosition) SetQuantity(quantity float64) *InventoryPosition {
+func (inventoryPosition *InventoryPosition) SetQuantity(quantity Decimal) *InventoryPosition {
 	inventoryPosition.Quantity = &quantity
 	return inventoryPosition
 }
@@ -471,6 +476,11 @@ type InventoryService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Inventory], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех инвентаризаций постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Inventory, error]
+
 	// Create выполняет запрос на создание инвентаризации.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -527,6 +537,11 @@ type InventoryService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InventoryPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InventoryPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
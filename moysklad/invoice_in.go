@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -17,7 +18,7 @@ import (
 type InvoiceIn struct {
 	OrganizationAccount  *AgentAccount                 `json:"organizationAccount,omitempty"`  // Метаданные счета юрлица
 	Created              *Timestamp                    `json:"created,omitempty"`              // Дата создания
-	PayedSum             *float64                      `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету поставщика
+	PayedSum             *Decimal                      `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету поставщика
 	Applicable           *bool                         `json:"applicable,omitempty"`           // Отметка о проведении
 	Supplies             Slice[Supply]                 `json:"supplies,omitempty"`             // Ссылки на связанные приемки
 	Code                 *string                       `json:"code,omitempty"`                 // Код Счета поставщика
@@ -45,18 +46,20 @@ type InvoiceIn struct {
 	Published            *bool                         `json:"published,omitempty"`            // Опубликован ли документ
 	Rate                 *NullValue[Rate]              `json:"rate,omitempty"`                 // Валюта
 	Shared               *bool                         `json:"shared,omitempty"`               // Общий доступ
-	ShippedSum           *float64                      `json:"shippedSum,omitempty"`           // Сумма отгруженного
+	ShippedSum           *Decimal                      `json:"shippedSum,omitempty"`           // Сумма отгруженного
 	State                *NullValue[State]             `json:"state,omitempty"`                // Метаданные статуса счета поставщика
 	Store                *NullValue[Store]             `json:"store,omitempty"`                // Метаданные склада
-	Sum                  *float64                      `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
+	Sum                  *Decimal                      `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
 	SyncID               *string                       `json:"syncId,omitempty"`               // ID синхронизации
 	Updated              *Timestamp                    `json:"updated,omitempty"`              // Момент последнего обновления Счета поставщика
 	VatEnabled           *bool                         `json:"vatEnabled,omitempty"`           // Учитывается ли НДС
 	VatIncluded          *bool                         `json:"vatIncluded,omitempty"`          // Включен ли НДС в цену
-	VatSum               *float64                      `json:"vatSum,omitempty"`               // Сумма НДС
+	VatSum               *Decimal                      `json:"vatSum,omitempty"`               // Сумма НДС
 	Payments             Slice[Payment]                `json:"payments,omitempty"`             // Массив ссылок на связанные операции
 	PurchaseOrder        *PurchaseOrder                `json:"purchaseOrder,omitempty"`        // Ссылка на связанный заказ поставщику
 	Attributes           Slice[Attribute]              `json:"attributes,omitempty"`           // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -95,7 +98,7 @@ func (invoiceIn InvoiceIn) GetCreated() time.Time {
 }
 
 // GetPayedSum возвращает Сумму входящих платежей по Счету поставщика.
-func (invoiceIn InvoiceIn) GetPayedSum() float64 {
+func (invoiceIn InvoiceIn) GetPayedSum() Decimal {
 	return Deref(invoiceIn.PayedSum)
 }
 
@@ -235,7 +238,7 @@ func (invoiceIn InvoiceIn) GetShared() bool {
 }
 
 // GetShippedSum возвращает Сумму отгруженного.
-func (invoiceIn InvoiceIn) GetShippedSum() float64 {
+func (invoiceIn InvoiceIn) GetShippedSum() Decimal {
 	return Deref(invoiceIn.ShippedSum)
 }
 
@@ -250,7 +253,7 @@ func (invoiceIn InvoiceIn) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Счета поставщика в установленной валюте.
-func (invoiceIn InvoiceIn) GetSum() float64 {
+func (invoiceIn InvoiceIn) GetSum() Decimal {
 	return Deref(invoiceIn.Sum)
 }
 
@@ -275,7 +278,7 @@ func (invoiceIn InvoiceIn) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (invoiceIn InvoiceIn) GetVatSum() float64 {
+func (invoiceIn InvoiceIn) GetVatSum() Decimal {
 	return Deref(invoiceIn.VatSum)
 }
 
@@ -554,11 +557,13 @@ type InvoiceInPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -589,12 +594,12 @@ func (invoiceInPosition InvoiceInPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (invoiceInPosition InvoiceInPosition) GetPrice() float64 {
+func (invoiceInPosition InvoiceInPosition) GetPrice() Decimal {
 	return Deref(invoiceInPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров/услуг данного вида в компоненте.
-func (invoiceInPosition InvoiceInPosition) GetQuantity() float64 {
+func (invoiceInPosition InvoiceInPosition) GetQuantity() Decimal {
 	return Deref(invoiceInPosition.Quantity)
 }
 
@@ -640,7 +645,7 @@ func (invoiceInPosition *InvoiceInPosition) SetPack(pack *Pack) *InvoiceInPositi
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (invoiceInPosition *InvoiceInPosition) SetPrice(price float64) *InvoiceInPosition {
+func (invoiceInPosition *InvoiceInPosition) SetPrice(price Decimal) *InvoiceInPosition {
 	invoiceInPosition.Price = &price
 	return invoiceInPosition
 }
@@ -649,7 +654,7 @@ func (invoiceInPosition *InvoiceInPosition) SetPrice(price float64) *InvoiceInPo
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (invoiceInPosition *InvoiceInPosition) SetQuantity(quantity float64) *InvoiceInPosition {
+func (invoiceInPosition *InvoiceInPosition) SetQuantity(quantity Decimal) *InvoiceInPosition {
 	invoiceInPosition.Quantity = &quantity
 	return invoiceInPosition
 }
@@ -688,6 +693,11 @@ type InvoiceInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[InvoiceIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех счетов поставщиков постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*InvoiceIn, error]
+
 	// Create выполняет запрос на создание счета поставщика.
 	// Обязательные поля для заполнения:
 	//	- name (Номер Счета поставщика)
@@ -753,6 +763,11 @@ type InvoiceInService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InvoiceInPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InvoiceInPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -15,7 +16,7 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-schet-pokupatelu
 type InvoiceOut struct {
-	PayedSum             *float64                       `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету покупателю
+	PayedSum             *Decimal                       `json:"payedSum,omitempty"`             // Сумма входящих платежей по Счету покупателю
 	VatEnabled           *bool                          `json:"vatEnabled,omitempty"`           // Учитывается ли НДС
 	AgentAccount         *AgentAccount                  `json:"agentAccount,omitempty"`         // Метаданные счета контрагента
 	Applicable           *bool                          `json:"applicable,omitempty"`           // Отметка о проведении
@@ -43,19 +44,21 @@ type InvoiceOut struct {
 	Published            *bool                          `json:"published,omitempty"`            // Опубликован ли документ
 	Rate                 *NullValue[Rate]               `json:"rate,omitempty"`                 // Валюта
 	Shared               *bool                          `json:"shared,omitempty"`               // Общий доступ
-	ShippedSum           *float64                       `json:"shippedSum,omitempty"`           // Сумма отгруженного
+	ShippedSum           *Decimal                       `json:"shippedSum,omitempty"`           // Сумма отгруженного
 	State                *NullValue[State]              `json:"state,omitempty"`                // Метаданные статуса счета
 	Store                *NullValue[Store]              `json:"store,omitempty"`                // Метаданные склада
-	Sum                  *float64                       `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
+	Sum                  *Decimal                       `json:"sum,omitempty"`                  // Сумма Счета в установленной валюте
 	SyncID               *string                        `json:"syncId,omitempty"`               // ID синхронизации
 	Updated              *Timestamp                     `json:"updated,omitempty"`              // Момент последнего обновления Счета покупателю
 	Owner                *Employee                      `json:"owner,omitempty"`                // Метаданные владельца (Сотрудника)
 	VatIncluded          *bool                          `json:"vatIncluded,omitempty"`          // Включен ли НДС в цену
-	VatSum               *float64                       `json:"vatSum,omitempty"`               // Сумма НДС
+	VatSum               *Decimal                       `json:"vatSum,omitempty"`               // Сумма НДС
 	CustomerOrder        *CustomerOrder                 `json:"customerOrder,omitempty"`        // Ссылка на Заказ Покупателя, с которым связан этот Счет покупателю
 	SalesChannel         *SalesChannel                  `json:"salesChannel,omitempty"`         // Метаданные канала продаж
 	Payments             Slice[Payment]                 `json:"payments,omitempty"`             // Массив ссылок на связанные операции
 	Attributes           Slice[Attribute]               `json:"attributes,omitempty"`           // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -84,7 +87,7 @@ func (invoiceOut InvoiceOut) AsOperationIn() *Operation {
 }
 
 // GetPayedSum возвращает Сумму входящих платежей по Счету покупателю.
-func (invoiceOut InvoiceOut) GetPayedSum() float64 {
+func (invoiceOut InvoiceOut) GetPayedSum() Decimal {
 	return Deref(invoiceOut.PayedSum)
 }
 
@@ -224,7 +227,7 @@ func (invoiceOut InvoiceOut) GetShared() bool {
 }
 
 // GetShippedSum возвращает Сумму отгруженного.
-func (invoiceOut InvoiceOut) GetShippedSum() float64 {
+func (invoiceOut InvoiceOut) GetShippedSum() Decimal {
 	return Deref(invoiceOut.ShippedSum)
 }
 
@@ -239,7 +242,7 @@ func (invoiceOut InvoiceOut) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Счета покупателю в установленной валюте.
-func (invoiceOut InvoiceOut) GetSum() float64 {
+func (invoiceOut InvoiceOut) GetSum() Decimal {
 	return Deref(invoiceOut.Sum)
 }
 
@@ -264,7 +267,7 @@ func (invoiceOut InvoiceOut) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (invoiceOut InvoiceOut) GetVatSum() float64 {
+func (invoiceOut InvoiceOut) GetVatSum() Decimal {
 	return Deref(invoiceOut.VatSum)
 }
 
@@ -538,11 +541,13 @@ type InvoiceOutPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID сущности
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -573,12 +578,12 @@ func (invoiceOutPosition InvoiceOutPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (invoiceOutPosition InvoiceOutPosition) GetPrice() float64 {
+func (invoiceOutPosition InvoiceOutPosition) GetPrice() Decimal {
 	return Deref(invoiceOutPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (invoiceOutPosition InvoiceOutPosition) GetQuantity() float64 {
+func (invoiceOutPosition InvoiceOutPosition) GetQuantity() Decimal {
 	return Deref(invoiceOutPosition.Quantity)
 }
 
@@ -624,13 +629,13 @@ func (invoiceOutPosition *InvoiceOutPosition) SetPack(pack *Pack) *InvoiceOutPos
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (invoiceOutPosition *InvoiceOutPosition) SetPrice(price float64) *InvoiceOutPosition {
+func (invoiceOutPosition *InvoiceOutPosition) SetPrice(price Decimal) *InvoiceOutPosition {
 	invoiceOutPosition.Price = &price
 	return invoiceOutPosition
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (invoiceOutPosition *InvoiceOutPosition) SetQuantity(quantity float64) *InvoiceOutPosition {
+func (invoiceOutPosition *InvoiceOutPosition) SetQuantity(quantity Decimal) *InvoiceOutPosition {
 	invoiceOutPosition.Quantity = &quantity
 	return invoiceOutPosition
 }
@@ -669,6 +674,11 @@ type InvoiceOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[InvoiceOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех счетов покупателям постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*InvoiceOut, error]
+
 	// Create выполняет запрос на создание счета покупателю.
 	// Обязательные поля для заполнения:
 	//	- name (Номер Счета покупателю)
@@ -734,6 +744,11 @@ type InvoiceOutService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[InvoiceOutPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*InvoiceOutPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -0,0 +1,150 @@
+package moysklad
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Limiter описывает ограничитель запросов к API.
+//
+// Перед каждой попыткой запроса вызывается Acquire, после её завершения – Release.
+// Заголовки каждого полученного ответа передаются в Observe.
+//
+// Реализация должна быть безопасной для использования из нескольких горутин.
+type Limiter interface {
+	// Acquire блокирует выполнение до получения разрешения на запрос.
+	// Возвращает ошибку контекста, если контекст был отменён раньше.
+	Acquire(ctx context.Context) error
+
+	// Release освобождает слот параллельного запроса, полученный вызовом Acquire.
+	Release()
+
+	// Observe принимает заголовки ответа и корректирует темп запросов.
+	Observe(header http.Header)
+}
+
+// NewLimiter возвращает [Limiter], ограничивающий количество параллельных запросов значением parallel,
+// а частоту запросов – значением requests за период window.
+//
+// Темп запросов подстраивается под значения заголовков X-RateLimit-Limit, X-RateLimit-Remaining
+// и X-Lognex-Retry-TimeInterval: пока запас запросов велик, запросы выполняются без задержки,
+// по мере его исчерпания интервал между запросами растёт вплоть до длины периода.
+//
+// Один экземпляр можно передать в конфигурацию нескольких клиентов одной учётной записи.
+func NewLimiter(requests int, window time.Duration, parallel int) Limiter {
+	requests = max(requests, 1)
+	parallel = max(parallel, 1)
+
+	return &limiter{
+		slots:  make(chan struct{}, parallel),
+		window: window,
+		pace:   window / time.Duration(requests),
+	}
+}
+
+// DefaultLimiter возвращает [Limiter] с ограничениями МойСклад по умолчанию:
+// не более 45 запросов за 3 секунды и не более 5 параллельных запросов.
+func DefaultLimiter() Limiter {
+	return NewLimiter(MaxQueriesPerSecond*3, rateLimitWindow, MaxQueriesPerUser)
+}
+
+type limiter struct {
+	next   time.Time     // Момент, раньше которого нельзя выполнить следующий запрос
+	slots  chan struct{} // Слоты для управления параллельными запросами
+	window time.Duration // Период, на который выдаётся лимит запросов
+	pace   time.Duration // Текущий интервал между запросами
+	mu     sync.Mutex
+}
+
+func (limiter *limiter) Acquire(ctx context.Context) error {
+	select {
+	case limiter.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	limiter.mu.Lock()
+	at := time.Now()
+	if limiter.next.After(at) {
+		at = limiter.next
+	}
+	limiter.next = at.Add(limiter.pace)
+	limiter.mu.Unlock()
+
+	if err := sleepContext(ctx, time.Until(at)); err != nil {
+		limiter.Release()
+		return err
+	}
+
+	return nil
+}
+
+func (limiter *limiter) Release() {
+	<-limiter.slots
+}
+
+func (limiter *limiter) Observe(header http.Header) {
+	limit, err := strconv.Atoi(header.Get(headerRateLimit))
+	if err != nil || limit <= 0 {
+		return
+	}
+
+	remaining, err := strconv.Atoi(header.Get(headerRateRemaining))
+	if err != nil {
+		return
+	}
+
+	window := limiter.window
+	if ms, err := strconv.Atoi(header.Get(headerRetryTimeInterval)); err == nil && ms > 0 {
+		window = time.Duration(ms) * time.Millisecond
+	}
+
+	// при полном запасе запросов задержка отсутствует, при половинном равна window/limit,
+	// при исчерпании приближается к window.
+	remaining = Clamp(remaining, 0, limit)
+	pace := window * time.Duration(limit-remaining) / time.Duration(limit*max(remaining, 1))
+	pace = min(pace, window)
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.pace = pace
+
+	if remaining == 0 {
+		if wait := retryAfter(header); wait > 0 {
+			if until := time.Now().Add(wait); until.After(limiter.next) {
+				limiter.next = until
+			}
+		}
+	}
+}
+
+// retryAfter возвращает время до сброса ограничения из заголовков X-Lognex-Retry-After или X-Lognex-Reset.
+func retryAfter(header http.Header) time.Duration {
+	for _, name := range []string{headerRetryAfter, headerRateReset} {
+		if ms, err := strconv.Atoi(header.Get(name)); err == nil && ms > 0 {
+			return time.Duration(ms) * time.Millisecond
+		}
+	}
+	return 0
+}
+
+// sleepContext ожидает в течение d или до отмены контекста.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
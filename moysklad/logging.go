@@ -0,0 +1,119 @@
+package moysklad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-resty/resty/v2"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// redacted заменяет секретные значения в журнале.
+const redacted = "[REDACTED]"
+
+// secretFieldPattern находит секретные значения в JSON телах запросов и ответов
+// (например, токен в ответе на запрос security/token).
+var secretFieldPattern = regexp.MustCompile(`("(?:access_token|password)"\s*:\s*")(?:[^"\\]|\\.)*"`)
+
+// secretHeaders заголовки, значения которых не попадают в журнал.
+var secretHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// logError записывает в журнал клиента ошибку err, добавляя коды ошибок API, если они есть.
+func (client *Client) logError(ctx context.Context, msg string, err error, attrs ...slog.Attr) {
+	attrs = append(attrs, slog.Any("error", err))
+
+	var apiErrors ApiErrors
+	if errors.As(err, &apiErrors) {
+		if errorCodes := apiErrors.Codes(); len(errorCodes) > 0 {
+			attrs = append(attrs, slog.Any("code", errorCodes))
+		}
+	}
+
+	client.logger.LogAttrs(ctx, slog.LevelError, msg, attrs...)
+}
+
+// logExchange записывает в журнал клиента тела запроса и ответа с уровнем [slog.LevelDebug],
+// если установлен флаг Config.LogBodies.
+//
+// Заголовки авторизации и токены в теле заменяются на [redacted].
+func (client *Client) logExchange(ctx context.Context, info *RequestInfo, resp *resty.Response, duration time.Duration) {
+	if !client.logBodies || !client.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", info.Method),
+		slog.String("uri", info.URI),
+		slog.Duration("duration", duration),
+	}
+
+	if body := requestBodyString(info.Body); body != "" {
+		attrs = append(attrs, slog.String("request_body", redactBody(body)))
+	}
+
+	if resp != nil && resp.RawResponse != nil {
+		if resp.Request != nil && resp.Request.RawRequest != nil {
+			attrs = append(attrs, slog.Any("request_headers", redactHeader(resp.Request.RawRequest.Header)))
+		}
+
+		attrs = append(attrs,
+			slog.Int("status", resp.StatusCode()),
+			slog.Any("response_headers", redactHeader(resp.Header())),
+		)
+
+		// тела файлов и печатных форм не выводятся
+		if strings.Contains(resp.Header().Get("Content-Type"), "json") {
+			attrs = append(attrs, slog.String("response_body", redactBody(string(resp.Body()))))
+		} else {
+			attrs = append(attrs, slog.Int("response_body_size", len(resp.Body())))
+		}
+	}
+
+	client.logger.LogAttrs(ctx, slog.LevelDebug, "moysklad: request", attrs...)
+}
+
+// requestBodyString возвращает тело запроса в том виде, в котором оно будет отправлено.
+func requestBodyString(body any) string {
+	switch v := body.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+}
+
+// redactBody заменяет значения секретных полей в теле JSON.
+func redactBody(body string) string {
+	return secretFieldPattern.ReplaceAllString(body, `${1}`+redacted+`"`)
+}
+
+// redactHeader возвращает копию заголовков header с заменёнными значениями секретных заголовков.
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, key := range secretHeaders {
+		if header.Get(key) != "" {
+			header.Set(key, redacted)
+		}
+	}
+	return header
+}
+
+// responseURL возвращает адрес запроса, на который получен ответ resp.
+func responseURL(resp *resty.Response) string {
+	if resp == nil || resp.Request == nil {
+		return ""
+	}
+	return resp.Request.URL
+}
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -39,11 +40,13 @@ type Loss struct {
 	Shared       *bool                    `json:"shared,omitempty"`       // Общий доступ
 	State        *NullValue[State]        `json:"state,omitempty"`        // Метаданные статуса Списания
 	Store        *Store                   `json:"store,omitempty"`        // Метаданные склада
-	Sum          *float64                 `json:"sum,omitempty"`          // Сумма Списания в копейках
+	Sum          *Decimal                 `json:"sum,omitempty"`          // Сумма Списания в копейках
 	Name         *string                  `json:"name,omitempty"`         // Наименование Списания
 	Updated      *Timestamp               `json:"updated,omitempty"`      // Момент последнего обновления Списания
 	Inventory    *Inventory               `json:"inventory,omitempty"`    // Ссылка на связанную со списанием инвентаризацию
 	Attributes   Slice[Attribute]         `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -182,7 +185,7 @@ func (loss Loss) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Списания в копейках.
-func (loss Loss) GetSum() float64 {
+func (loss Loss) GetSum() Decimal {
 	return Deref(loss.Sum)
 }
 
@@ -380,11 +383,13 @@ type LossPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Reason     *string             `json:"reason,omitempty"`     // Причина списания данной позиции
 	Slot       *Slot               `json:"slot,omitempty"`       // Ячейка на складе
 	Things     Slice[string]       `json:"things,omitempty"`     // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -408,12 +413,12 @@ func (lossPosition LossPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (lossPosition LossPosition) GetPrice() float64 {
+func (lossPosition LossPosition) GetPrice() Decimal {
 	return Deref(lossPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (lossPosition LossPosition) GetQuantity() float64 {
+func (lossPosition LossPosition) GetQuantity() Decimal {
 	return Deref(lossPosition.Quantity)
 }
 
@@ -454,7 +459,7 @@ func (lossPosition *LossPosition) SetPack(pack *Pack) *LossPosition {
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (lossPosition *LossPosition) SetPrice(price float64) *LossPosition {
+func (lossPosition *LossPosition) SetPrice(price Decimal) *LossPosition {
 	lossPosition.Price = &price
 	return lossPosition
 }
@@ -463,7 +468,7 @@ func (lossPosition *LossPosition) SetPrice(price float64) *LossPosition {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (lossPosition *LossPosition) SetQuantity(quantity float64) *LossPosition {
+func (lossPosition *LossPosition) SetQuantity(quantity Decimal) *LossPosition {
 	lossPosition.Quantity = &quantity
 	return lossPosition
 }
@@ -515,6 +520,11 @@ type LossService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Loss], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех списаний постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Loss, error]
+
 	// Create выполняет запрос на создание списания.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -579,6 +589,11 @@ type LossService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[LossPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*LossPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -111,19 +111,15 @@ func (meta *Meta) IsEqual(other *Meta) bool {
 // Возвращает "<empty id>", если поле Href пустое или не содержит идентификатора.
 func (meta Meta) GetUUIDFromHref() string {
 	href := Deref(meta.Href)
-	if href == "" {
-		return "<empty id>"
-	}
-
-	sep := strings.Split(href, "/")
-	if len(sep) == 0 {
-		return "<empty id>"
-	}
 
-	id := sep[len(sep)-1]
+	// ID – последний сегмент пути независимо от базового адреса ссылки
+	href, _, _ = strings.Cut(href, "?")
+	href, _, _ = strings.Cut(href, "#")
+	href = strings.TrimSuffix(href, "/")
 
-	if strings.Contains(id, "?") {
-		id = strings.Split(id, "?")[0]
+	id := href[strings.LastIndex(href, "/")+1:]
+	if id == "" {
+		return "<empty id>"
 	}
 
 	return id
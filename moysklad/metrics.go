@@ -0,0 +1,57 @@
+package moysklad
+
+import (
+	"github.com/go-resty/resty/v2"
+	"strconv"
+	"time"
+)
+
+// Metrics описывает получателя метрик клиента.
+//
+// Реализация должна быть безопасной для использования из нескольких горутин.
+// Готовая реализация для Prometheus находится в пакете prommetrics.
+type Metrics interface {
+	// ObserveRequest вызывается по завершении каждой попытки HTTP-запроса.
+	// Параметр status равен 0, если ответ не был получен.
+	ObserveRequest(entity, method string, status int, duration time.Duration)
+
+	// ObserveRateLimitRemaining принимает значение заголовка X-RateLimit-Remaining.
+	ObserveRateLimitRemaining(remaining int)
+
+	// ObserveLimiterWait принимает время ожидания разрешения [Limiter].
+	ObserveLimiterWait(duration time.Duration)
+
+	// IncRetry вызывается перед каждой повторной попыткой запроса.
+	IncRetry(entity, method string)
+
+	// AddInFlight изменяет количество выполняемых запросов на delta.
+	// Количество следует сопоставлять с ограничением MaxQueriesPerUser.
+	AddInFlight(delta int)
+
+	// IncAsyncPoll вызывается при каждой проверке статуса асинхронной задачи.
+	IncAsyncPoll()
+}
+
+// noopMetrics реализация [Metrics], которая ничего не делает.
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveRequest(string, string, int, time.Duration) {}
+func (noopMetrics) ObserveRateLimitRemaining(int)                     {}
+func (noopMetrics) ObserveLimiterWait(time.Duration)                  {}
+func (noopMetrics) IncRetry(string, string)                           {}
+func (noopMetrics) AddInFlight(int)                                   {}
+func (noopMetrics) IncAsyncPoll()                                     {}
+
+// observeResponse передаёт в metrics сведения о завершённой попытке запроса.
+func observeResponse(metrics Metrics, info *RequestInfo, resp *resty.Response, duration time.Duration) {
+	var status int
+	if resp != nil && resp.RawResponse != nil {
+		status = resp.StatusCode()
+
+		if remaining, err := strconv.Atoi(resp.Header().Get(headerRateRemaining)); err == nil {
+			metrics.ObserveRateLimitRemaining(remaining)
+		}
+	}
+
+	metrics.ObserveRequest(entityFromURI(info.URI), info.Method, status, duration)
+}
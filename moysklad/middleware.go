@@ -0,0 +1,54 @@
+package moysklad
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"net/url"
+)
+
+// RequestInfo сведения о выполняемом запросе, доступные в [Middleware].
+//
+// Изменения полей Method, URI, Params и Body, а также заголовков Request
+// учитываются при выполнении запроса.
+type RequestInfo struct {
+	Request    *resty.Request // Запрос
+	Params     url.Values     // Параметры запроса
+	Body       any            // Тело запроса
+	Method     string         // HTTP-метод
+	URI        string         // Путь запроса относительно базового адреса API
+	ResultType string         // Имя типа, в который будет декодирован ответ
+}
+
+// Handler выполняет запрос, описанный [RequestInfo], и возвращает ответ.
+type Handler func(ctx context.Context, info *RequestInfo) (*resty.Response, error)
+
+// Middleware оборачивает [Handler], позволяя выполнить действия до и после запроса,
+// изменить запрос или подменить ответ.
+//
+// Middleware применяются ко всем запросам клиента, включая асинхронные запросы, [FetchMeta] и печать документов.
+// Повторные попытки [RetryPolicy] и ограничения [Limiter] выполняются внутри цепочки.
+//
+// # Пример:
+//
+//	audit := func(next moysklad.Handler) moysklad.Handler {
+//		return func(ctx context.Context, info *moysklad.RequestInfo) (*resty.Response, error) {
+//			resp, err := next(ctx, info)
+//			log.Println(info.Method, info.URI, resp.StatusCode())
+//			return resp, err
+//		}
+//	}
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:       "MS_TOKEN_HERE",
+//		Middlewares: []moysklad.Middleware{audit},
+//	})
+type Middleware func(next Handler) Handler
+
+// chain оборачивает handler в цепочку middleware клиента.
+// Первая middleware в списке выполняется первой.
+func (client *Client) chain(handler Handler) Handler {
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		handler = client.middlewares[i](handler)
+	}
+	return handler
+}
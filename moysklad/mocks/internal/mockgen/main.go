@@ -0,0 +1,579 @@
+// Command mockgen генерирует моки интерфейсов сервисов пакета moysklad для пакета mocks.
+//
+// Мок создаётся для каждого экспортируемого интерфейса, название которого оканчивается на Service,
+// а также для фасада клиента API. Интерфейс, все методы которого без аргументов возвращают
+// другие сервисы, считается группой: его мок возвращает вложенные моки из полей.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// facade название интерфейса фасада клиента и его мока.
+const (
+	facade     = "API"
+	facadeMock = "Client"
+)
+
+// reserved методы [mocks.Recorder], которые не могут совпадать с методами сервисов.
+var reserved = []string{"Calls", "CallsOf", "ResetCalls"}
+
+var versionSuffix = regexp.MustCompile(`^v[0-9]+$`)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mockgen: ")
+
+	src := flag.String("src", "..", "каталог пакета moysklad")
+	out := flag.String("out", "services_gen.go", "файл с моками")
+	pkg := flag.String("pkg", "github.com/EnOane/go-moysklad/moysklad", "путь импорта пакета moysklad")
+	flag.Parse()
+
+	g, err := load(*src, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := g.generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(*out, source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generator собирает интерфейсы пакета и формирует исходный код моков.
+type generator struct {
+	pkgPath    string
+	types      map[string]bool          // Экспортируемые типы пакета
+	imports    map[string]string        // Путь импорта по названию пакета
+	interfaces map[string]*ast.TypeSpec // Интерфейсы, для которых создаются моки
+	used       map[string]bool          // Пути импортов, используемых в моках
+	buf        bytes.Buffer
+}
+
+// load разбирает файлы пакета в каталоге dir.
+func load(dir, pkgPath string) (*generator, error) {
+	g := &generator{
+		pkgPath:    pkgPath,
+		types:      make(map[string]bool),
+		imports:    make(map[string]string),
+		interfaces: make(map[string]*ast.TypeSpec),
+		used:       map[string]bool{pkgPath: true},
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			g.imports[importName(spec, path)] = path
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if !typeSpec.Name.IsExported() {
+					continue
+				}
+				g.types[typeSpec.Name.Name] = true
+
+				name := typeSpec.Name.Name
+				if _, ok := typeSpec.Type.(*ast.InterfaceType); ok && (strings.HasSuffix(name, "Service") || name == facade) {
+					g.interfaces[name] = typeSpec
+				}
+			}
+		}
+	}
+
+	if len(g.interfaces) == 0 {
+		return nil, fmt.Errorf("в каталоге %s нет интерфейсов сервисов", dir)
+	}
+
+	return g, nil
+}
+
+func importName(spec *ast.ImportSpec, path string) string {
+	if spec.Name != nil {
+		return spec.Name.Name
+	}
+
+	parts := strings.Split(path, "/")
+	name := parts[len(parts)-1]
+	if versionSuffix.MatchString(name) && len(parts) > 1 {
+		name = parts[len(parts)-2]
+	}
+	return name
+}
+
+// method метод интерфейса.
+type method struct {
+	name     string
+	params   []param
+	results  []string
+	variadic bool
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+// generate возвращает отформатированный исходный код моков.
+func (g *generator) generate() ([]byte, error) {
+	names := make([]string, 0, len(g.interfaces))
+	for name := range g.interfaces {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var body bytes.Buffer
+	for _, name := range names {
+		spec := g.interfaces[name]
+		methods, err := g.methods(spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		g.buf.Reset()
+		if g.isGroup(spec, methods) {
+			g.group(name, methods)
+		} else {
+			g.leaf(spec, methods)
+		}
+		body.Write(g.buf.Bytes())
+	}
+
+	var file bytes.Buffer
+	file.WriteString("// Code generated by go run ./internal/mockgen. DO NOT EDIT.\n\n")
+	file.WriteString("package mocks\n\nimport (\n")
+
+	paths := make([]string, 0, len(g.used))
+	for path := range g.used {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	// стандартная библиотека отделяется от внешних пакетов
+	std := func(path string) bool {
+		first, _, _ := strings.Cut(path, "/")
+		return !strings.Contains(first, ".")
+	}
+	slices.SortStableFunc(paths, func(a, b string) int {
+		switch {
+		case std(a) == std(b):
+			return 0
+		case std(a):
+			return -1
+		default:
+			return 1
+		}
+	})
+	for i, path := range paths {
+		if i > 0 && std(paths[i-1]) != std(path) {
+			file.WriteString("\n")
+		}
+		fmt.Fprintf(&file, "\t%q\n", path)
+	}
+	file.WriteString(")\n\n")
+
+	file.WriteString("var (\n")
+	for _, name := range names {
+		spec := g.interfaces[name]
+		args := ""
+		if spec.TypeParams != nil {
+			args = "[" + strings.Repeat("any, ", spec.TypeParams.NumFields()-1) + "any]"
+		}
+		fmt.Fprintf(&file, "\t_ moysklad.%s%s = (*%s%s)(nil)\n", name, args, mockName(name), args)
+	}
+	file.WriteString(")\n")
+	file.Write(body.Bytes())
+
+	source, err := format.Source(file.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("форматирование: %w\n%s", err, file.Bytes())
+	}
+	return source, nil
+}
+
+func mockName(name string) string {
+	if name == facade {
+		return facadeMock
+	}
+	return name
+}
+
+// methods возвращает методы интерфейса spec.
+func (g *generator) methods(spec *ast.TypeSpec) ([]method, error) {
+	typeParams := make(map[string]bool)
+	if spec.TypeParams != nil {
+		for _, field := range spec.TypeParams.List {
+			for _, name := range field.Names {
+				typeParams[name.Name] = true
+			}
+		}
+	}
+
+	var methods []method
+	for _, field := range spec.Type.(*ast.InterfaceType).Methods.List {
+		if len(field.Names) == 0 {
+			return nil, fmt.Errorf("встроенные интерфейсы не поддерживаются")
+		}
+
+		name := field.Names[0].Name
+		if slices.Contains(reserved, name) || strings.HasSuffix(name, "Func") {
+			return nil, fmt.Errorf("метод %s конфликтует с полями мока", name)
+		}
+
+		fn := field.Type.(*ast.FuncType)
+		m := method{name: name}
+
+		for _, p := range fn.Params.List {
+			typ, err := g.typeString(p.Type, typeParams)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			if _, ok := p.Type.(*ast.Ellipsis); ok {
+				m.variadic = true
+			}
+
+			names := p.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{{Name: "_"}}
+			}
+			for _, ident := range names {
+				paramName := ident.Name
+				if paramName == "_" || paramName == "mock" {
+					paramName = fmt.Sprintf("p%d", len(m.params))
+				}
+				m.params = append(m.params, param{name: paramName, typ: typ})
+			}
+		}
+
+		if fn.Results != nil {
+			for _, r := range fn.Results.List {
+				typ, err := g.typeString(r.Type, typeParams)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", name, err)
+				}
+				for range max(len(r.Names), 1) {
+					m.results = append(m.results, typ)
+				}
+			}
+		}
+
+		methods = append(methods, m)
+	}
+
+	return methods, nil
+}
+
+// isGroup возвращает «true», если все методы интерфейса возвращают другие сервисы.
+func (g *generator) isGroup(spec *ast.TypeSpec, methods []method) bool {
+	if spec.TypeParams != nil || len(methods) == 0 {
+		return false
+	}
+
+	seen := make(map[string]bool)
+	for _, m := range methods {
+		if len(m.params) != 0 || len(m.results) != 1 {
+			return false
+		}
+
+		name, ok := strings.CutPrefix(m.results[0], "moysklad.")
+		if !ok || g.interfaces[name] == nil || seen[name] {
+			return false
+		}
+		seen[name] = true
+	}
+	return true
+}
+
+// group формирует мок группы сервисов name.
+func (g *generator) group(name string, methods []method) {
+	mock := mockName(name)
+
+	g.printf("\n// %s мок [moysklad.%s]. Методы возвращают моки из полей.\n", mock, name)
+	g.printf("type %s struct {\n", mock)
+	for _, m := range methods {
+		field := strings.TrimPrefix(m.results[0], "moysklad.")
+		g.printf("%s *%s\n", field, mockName(field))
+	}
+	g.printf("}\n")
+
+	g.printf("\n// New%s возвращает мок [moysklad.%s] со всеми вложенными моками.\n", mock, name)
+	g.printf("func New%s() *%s {\n", mock, mock)
+	g.printf("return &%s{\n", mock)
+	for _, m := range methods {
+		field := strings.TrimPrefix(m.results[0], "moysklad.")
+		if g.isGroupName(field) {
+			g.printf("%s: New%s(),\n", field, mockName(field))
+		} else {
+			g.printf("%s: &%s{},\n", field, mockName(field))
+		}
+	}
+	g.printf("}\n}\n")
+
+	for _, m := range methods {
+		field := strings.TrimPrefix(m.results[0], "moysklad.")
+		g.printf("\n// %s возвращает мок %s.\n", m.name, field)
+		g.printf("func (mock *%s) %s() %s {\n", mock, m.name, m.results[0])
+		g.printf("return mock.%s\n}\n", field)
+	}
+}
+
+func (g *generator) isGroupName(name string) bool {
+	spec := g.interfaces[name]
+	methods, err := g.methods(spec)
+	return err == nil && g.isGroup(spec, methods)
+}
+
+// leaf формирует мок сервиса, методы которого вызывают функции из полей.
+func (g *generator) leaf(spec *ast.TypeSpec, methods []method) {
+	name := spec.Name.Name
+	receiver, typeParams := name, ""
+	if spec.TypeParams != nil {
+		var names, decls []string
+		for _, field := range spec.TypeParams.List {
+			constraint, _ := g.typeString(field.Type, nil)
+			for _, ident := range field.Names {
+				names = append(names, ident.Name)
+				decls = append(decls, ident.Name+" "+constraint)
+			}
+		}
+		receiver += "[" + strings.Join(names, ", ") + "]"
+		typeParams = "[" + strings.Join(decls, ", ") + "]"
+	}
+
+	g.printf("\n// %s мок [moysklad.%s].\n", name, name)
+	g.printf("type %s%s struct {\n", name, typeParams)
+	g.printf("Recorder\n\n")
+	for _, m := range methods {
+		g.printf("%sFunc func%s\n", m.name, m.signature())
+	}
+	g.printf("}\n")
+
+	for _, m := range methods {
+		g.printf("\nfunc (mock *%s) %s%s {\n", receiver, m.name, m.signature())
+
+		args := make([]string, 0, len(m.params))
+		for _, p := range m.params {
+			args = append(args, p.name)
+		}
+		record := append([]string{strconv.Quote(m.name)}, args...)
+		g.printf("mock.record(%s)\n", strings.Join(record, ", "))
+
+		call := "mock." + m.name + "Func(" + strings.Join(args, ", ")
+		if m.variadic {
+			call += "..."
+		}
+		call += ")"
+
+		if len(m.results) == 0 {
+			g.printf("if mock.%sFunc != nil {\n%s\n}\n}\n", m.name, call)
+			continue
+		}
+
+		g.printf("if mock.%sFunc != nil {\nreturn %s\n}\n", m.name, call)
+
+		results := make([]string, len(m.results))
+		for i, typ := range m.results {
+			last := i == len(m.results)-1
+			switch {
+			case last && typ == "error":
+				results[i] = fmt.Sprintf("notConfigured(%q, %q)", name, m.name)
+			case last && strings.HasPrefix(typ, "iter.Seq2[") && strings.HasSuffix(typ, ", error]"):
+				value := strings.TrimSuffix(strings.TrimPrefix(typ, "iter.Seq2["), ", error]")
+				results[i] = fmt.Sprintf("notConfiguredSeq[%s](%q, %q)", value, name, m.name)
+			default:
+				results[i] = fmt.Sprintf("r%d", i)
+				g.printf("var r%d %s\n", i, typ)
+			}
+		}
+		g.printf("return %s\n}\n", strings.Join(results, ", "))
+	}
+}
+
+// signature возвращает параметры и результаты метода.
+func (m method) signature() string {
+	params := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		params = append(params, p.name+" "+p.typ)
+	}
+
+	signature := "(" + strings.Join(params, ", ") + ")"
+	switch len(m.results) {
+	case 0:
+	case 1:
+		signature += " " + m.results[0]
+	default:
+		signature += " (" + strings.Join(m.results, ", ") + ")"
+	}
+	return signature
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// typeString возвращает запись типа expr в пакете mocks: типы пакета moysklad квалифицируются.
+func (g *generator) typeString(expr ast.Expr, typeParams map[string]bool) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if g.types[t.Name] && !typeParams[t.Name] {
+			return "moysklad." + t.Name, nil
+		}
+		return t.Name, nil
+
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("неподдерживаемый тип %T", t.X)
+		}
+		path, ok := g.imports[pkg.Name]
+		if !ok {
+			return "", fmt.Errorf("неизвестный пакет %s", pkg.Name)
+		}
+		g.used[path] = true
+		return pkg.Name + "." + t.Sel.Name, nil
+
+	case *ast.StarExpr:
+		elem, err := g.typeString(t.X, typeParams)
+		return "*" + elem, err
+
+	case *ast.Ellipsis:
+		elem, err := g.typeString(t.Elt, typeParams)
+		return "..." + elem, err
+
+	case *ast.ArrayType:
+		elem, err := g.typeString(t.Elt, typeParams)
+		if err != nil {
+			return "", err
+		}
+		if t.Len == nil {
+			return "[]" + elem, nil
+		}
+		lit, ok := t.Len.(*ast.BasicLit)
+		if !ok {
+			return "", fmt.Errorf("неподдерживаемая длина массива %T", t.Len)
+		}
+		return "[" + lit.Value + "]" + elem, nil
+
+	case *ast.MapType:
+		key, err := g.typeString(t.Key, typeParams)
+		if err != nil {
+			return "", err
+		}
+		value, err := g.typeString(t.Value, typeParams)
+		return "map[" + key + "]" + value, err
+
+	case *ast.IndexExpr:
+		return g.genericString(t.X, []ast.Expr{t.Index}, typeParams)
+
+	case *ast.IndexListExpr:
+		return g.genericString(t.X, t.Indices, typeParams)
+
+	case *ast.FuncType:
+		var params, results []string
+		for _, p := range t.Params.List {
+			typ, err := g.typeString(p.Type, typeParams)
+			if err != nil {
+				return "", err
+			}
+			for range max(len(p.Names), 1) {
+				params = append(params, typ)
+			}
+		}
+		if t.Results != nil {
+			for _, r := range t.Results.List {
+				typ, err := g.typeString(r.Type, typeParams)
+				if err != nil {
+					return "", err
+				}
+				for range max(len(r.Names), 1) {
+					results = append(results, typ)
+				}
+			}
+		}
+		s := "func(" + strings.Join(params, ", ") + ")"
+		switch len(results) {
+		case 0:
+		case 1:
+			s += " " + results[0]
+		default:
+			s += " (" + strings.Join(results, ", ") + ")"
+		}
+		return s, nil
+
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "any", nil
+		}
+
+	case *ast.ChanType:
+		elem, err := g.typeString(t.Value, typeParams)
+		if err != nil {
+			return "", err
+		}
+		switch t.Dir {
+		case ast.SEND:
+			return "chan<- " + elem, nil
+		case ast.RECV:
+			return "<-chan " + elem, nil
+		default:
+			return "chan " + elem, nil
+		}
+	}
+
+	return "", fmt.Errorf("неподдерживаемый тип %T", expr)
+}
+
+func (g *generator) genericString(x ast.Expr, indices []ast.Expr, typeParams map[string]bool) (string, error) {
+	base, err := g.typeString(x, typeParams)
+	if err != nil {
+		return "", err
+	}
+
+	args := make([]string, 0, len(indices))
+	for _, index := range indices {
+		arg, err := g.typeString(index, typeParams)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, arg)
+	}
+	return base + "[" + strings.Join(args, ", ") + "]", nil
+}
@@ -0,0 +1,104 @@
+// Package mocks содержит сгенерированные моки сервисов клиента МойСклад для тестов бизнес-логики.
+//
+// Для каждого интерфейса сервиса пакета moysklad (например, [moysklad.CustomerOrderService])
+// объявлен одноимённый мок. Метод мока вызывает функцию из поля с суффиксом Func
+// (например, GetByIDFunc для метода GetByID). Если функция не задана, метод возвращает нулевые значения
+// и ошибку, соответствующую [ErrNotConfigured], поэтому в тесте достаточно настроить только используемые методы.
+// Все вызовы записываются и доступны через [Recorder.Calls] и [Recorder.CallsOf].
+//
+// Моки сервисов-групп ([EntityService], [ReportService], [ContextService], [AccountService])
+// возвращают вложенные моки из полей, а [Client] реализует [moysklad.API] –
+// фасад клиента, который можно передавать в код вместо *[moysklad.Client].
+//
+// # Пример:
+//
+//	func TestShipOrder(t *testing.T) {
+//		client := mocks.NewClient()
+//		client.EntityService.CustomerOrderService.GetByIDFunc = func(ctx context.Context, id string, params ...func(*moysklad.Params)) (*moysklad.CustomerOrder, *resty.Response, error) {
+//			return &moysklad.CustomerOrder{Name: moysklad.String("00001")}, nil, nil
+//		}
+//
+//		err := ShipOrder(context.Background(), client, "id") // func ShipOrder(ctx context.Context, api moysklad.API, id string) error
+//		...
+//		if calls := client.EntityService.DemandService.CallsOf("Create"); len(calls) != 1 {
+//			t.Fatalf("ожидалось создание одной отгрузки, создано %d", len(calls))
+//		}
+//	}
+//
+// Моки генерируются командой go generate по интерфейсам пакета moysklad.
+package mocks
+
+//go:generate go run ./internal/mockgen -src .. -out services_gen.go
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+)
+
+// ErrNotConfigured возвращается методом мока, для которого не задана функция.
+var ErrNotConfigured = errors.New("mocks: method is not configured")
+
+// Call вызов метода мока.
+type Call struct {
+	Method string // Название метода
+	Args   []any  // Аргументы; аргументы с переменным числом передаются одним срезом
+}
+
+// Recorder записывает вызовы методов мока. Безопасен для конкурентного использования.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls возвращает все вызовы методов в порядке выполнения.
+func (recorder *Recorder) Calls() []Call {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	return slices.Clone(recorder.calls)
+}
+
+// CallsOf возвращает вызовы метода method в порядке выполнения.
+func (recorder *Recorder) CallsOf(method string) []Call {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	var calls []Call
+	for _, call := range recorder.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCalls удаляет записанные вызовы.
+func (recorder *Recorder) ResetCalls() {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.calls = nil
+}
+
+func (recorder *Recorder) record(method string, args ...any) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.calls = append(recorder.calls, Call{Method: method, Args: args})
+}
+
+// notConfigured возвращает ошибку вызова метода method мока service без заданной функции.
+func notConfigured(service, method string) error {
+	return fmt.Errorf("%w: %s.%s", ErrNotConfigured, service, method)
+}
+
+// notConfiguredSeq возвращает последовательность из одной ошибки notConfigured.
+func notConfiguredSeq[V any](service, method string) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		var zero V
+		yield(zero, notConfigured(service, method))
+	}
+}
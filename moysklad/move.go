@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -43,11 +44,13 @@ type Move struct {
 	Shared        *bool                     `json:"shared,omitempty"`        // Общий доступ
 	SourceStore   *Store                    `json:"sourceStore,omitempty"`   // Метаданные склада, с которого совершается перемещение
 	State         *NullValue[State]         `json:"state,omitempty"`         // Метаданные статуса Перемещения
-	Sum           *float64                  `json:"sum,omitempty"`           // Сумма Перемещения в копейках
+	Sum           *Decimal                  `json:"sum,omitempty"`           // Сумма Перемещения в копейках
 	SyncID        *string                   `json:"syncId,omitempty"`        // ID синхронизации
 	Supply        *Supply                   `json:"supply,omitempty"`        // Метаданные Приемки, связанной с Перемещением
 	TargetStore   *Store                    `json:"targetStore,omitempty"`   // Метаданные склада, на который совершается перемещение
 	Attributes    Slice[Attribute]          `json:"attributes,omitempty"`    // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -203,7 +206,7 @@ func (move Move) GetState() State {
 }
 
 // GetSum возвращает Сумму Перемещения в копейках.
-func (move Move) GetSum() float64 {
+func (move Move) GetSum() Decimal {
 	return Deref(move.Sum)
 }
 
@@ -432,13 +435,15 @@ type MovePosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Overhead   *float64            `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Перемещения не заданы, то накладные расходы нельзя задать
+	Overhead   *Decimal            `json:"overhead,omitempty"`   // Накладные расходы. Если Позиции Перемещения не заданы, то накладные расходы нельзя задать
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе
 	SourceSlot *Slot               `json:"sourceSlot,omitempty"` // Ячейка на складе, с которого совершается перемещение
 	TargetSlot *Slot               `json:"targetSlot,omitempty"` // Ячейка на складе, на который совершается перемещение
 	Things     Slice[string]       `json:"things,omitempty"`     // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -457,7 +462,7 @@ func (movePosition MovePosition) GetID() string {
 }
 
 // GetOverhead возвращает Накладные расходы.
-func (movePosition MovePosition) GetOverhead() float64 {
+func (movePosition MovePosition) GetOverhead() Decimal {
 	return Deref(movePosition.Overhead)
 }
 
@@ -467,12 +472,12 @@ func (movePosition MovePosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (movePosition MovePosition) GetPrice() float64 {
+func (movePosition MovePosition) GetPrice() Decimal {
 	return Deref(movePosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (movePosition MovePosition) GetQuantity() float64 {
+func (movePosition MovePosition) GetQuantity() Decimal {
 	return Deref(movePosition.Quantity)
 }
 
@@ -513,13 +518,13 @@ func (movePosition *MovePosition) SetPack(pack *Pack) *MovePosition {
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (movePosition *MovePosition) SetPrice(price float64) *MovePosition {
+func (movePosition *MovePosition) SetPrice(price Decimal) *MovePosition {
 	movePosition.Price = &price
 	return movePosition
 }
 
 // SetQuantity устанавливает Количество товаров/услуг данного вида в компоненте.
-func (movePosition *MovePosition) SetQuantity(quantity float64) *MovePosition {
+func (movePosition *MovePosition) SetQuantity(quantity Decimal) *MovePosition {
 	movePosition.Quantity = &quantity
 	return movePosition
 }
@@ -573,6 +578,11 @@ type MoveService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Move], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех перемещений постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Move, error]
+
 	// Create выполняет запрос на создание перемещения.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -638,6 +648,11 @@ type MoveService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[MovePosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*MovePosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -3,10 +3,12 @@ package moysklad
 import (
 	"fmt"
 	"github.com/go-resty/resty/v2"
-	"go.uber.org/ratelimit"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
 	"net/http"
 	"strconv"
-	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -20,28 +22,45 @@ const (
 	headerContentDisposition     = "Content-Disposition"                    // Заголовок содержит название файла при `X-Lognex-Get-Content: true`
 	MaxPositions                 = 1000                                     // Максимальное число объектов, передаваемых в одном массиве в запросе
 	MaxQueriesPerSecond          = 15                                       // Не более 45 запросов за 3 секундный период от аккаунта (45/3)
+	rateLimitWindow              = 3 * time.Second                          // Период, на который выдаётся лимит запросов от аккаунта
 	MaxQueriesPerUser            = 5                                        // Не более 5 параллельных запросов от одного пользователя
 	MaxPrintCount                = 1000                                     // Максимальное количество ценников/термоэтикеток
 	headerRateLimit              = "X-RateLimit-Limit"                      // Количество запросов, которые равномерно можно сделать в течение интервала до появления 429 ошибки.
 	headerRateRemaining          = "X-RateLimit-Remaining"                  // Число запросов, которые можно отправить до получения 429 ошибки.
 	headerRetryTimeInterval      = "X-Lognex-Retry-TimeInterval"            // Интервал в миллисекундах, в течение которого можно сделать эти запросы
+	headerRateReset              = "X-Lognex-Reset"                         // Время до сброса ограничения в миллисекундах. Равно нулю, если ограничение не установлено.
+	headerRetryAfter             = "X-Lognex-Retry-After"                   // Время до сброса ограничения в миллисекундах.
 
 	//MaxFiles                = 100                           // Максимальное количество файлов
 	//MaxImages               = 10                            // Максимальное количество изображений
-	//headerRateReset         = "X-Lognex-Reset"              // Время до сброса ограничения в миллисекундах. Равно нулю, если ограничение не установлено.
-	//headerRetryAfter        = "X-Lognex-Retry-After"        // Время до сброса ограничения в миллисекундах.
 )
 
 // Client базовый клиент для взаимодействия с API МойСклад.
 type Client struct {
-	nextReqTime time.Time
 	*resty.Client
-	limits *queryLimits
-	mu     sync.Mutex
+	limiter     Limiter
+	breaker     *CircuitBreaker
+	retryPolicy *RetryPolicy
+	middlewares []Middleware
+	tracer      trace.Tracer
+	metrics     Metrics
+	logger      *slog.Logger
+	logBodies   bool
+	cache       *responseCache
+	flights     *flightGroup
+	journal     *DryRunJournal
+	dryRun      atomic.Bool
+
+	preserveUnknownFields bool
+	onSchemaDrift         func(SchemaDrift)
+
+	location *time.Location
+
+	tokenProvider atomic.Pointer[TokenProvider]
 }
 
 // Config конфигурация клиента.
-// Обязательно указывать либо токен (в приоритете), либо логин и пароль.
+// Обязательно указывать либо источник токена (в приоритете), либо токен, либо логин и пароль.
 //
 // # Пример:
 //
@@ -56,10 +75,26 @@ type Config struct {
 	// Устанавливает заранее инициализированный клиент [http.Client].
 	HTTPClient *http.Client
 
-	// Токен (в приоритете).
+	// Базовый адрес API, например адрес прокси, зеркала или тестового сервера.
+	//
+	// Если не указан, используется https://api.moysklad.ru/api/remap/1.2/.
+	// Ссылки из ответов (Meta.Href, nextHref, адреса асинхронных задач) преобразуются в пути
+	// относительно базового адреса, поэтому запросы по ним выполняются через тот же адрес.
+	// Базовый адрес можно изменить методом SetBaseURL клиента.
+	BaseURL string
+
+	// Источник токена (в приоритете).
+	//
+	// Позволяет получать токен из внешних источников (например, хранилища секретов) и заменять его
+	// без пересоздания клиента. Может быть заменён методом [Client.SetTokenProvider].
+	TokenProvider TokenProvider
+
+	// Токен.
 	Token string
 
 	// Логин.
+	//
+	// Если токен не указан, клиент получает токен по логину и паролю с помощью [NewCredentialsTokenProvider].
 	Username string
 
 	// Пароль.
@@ -81,26 +116,122 @@ type Config struct {
 	//
 	// [Подробнее]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-vebhuki-primer-webhuka-zagolowok-wremennogo-otklucheniq-cherez-api
 	DisabledWebhookContent bool
+
+	// Политика повторного выполнения запросов при получении ответов 429, 5xx и сетевых ошибках.
+	//
+	// Если не указана, запросы не повторяются. См. [DefaultRetryPolicy].
+	RetryPolicy *RetryPolicy
+
+	// Ограничитель запросов.
+	//
+	// Если не указан, используется [DefaultLimiter].
+	// Для соблюдения общих ограничений учётной записи несколькими клиентами следует передавать им один экземпляр.
+	Limiter Limiter
+
+	// Автоматический выключатель запросов.
+	//
+	// Если указан, после серии ответов 5xx и тайм-аутов запросы отклоняются без обращения к API
+	// с ошибкой [*CircuitOpenError]. См. [CircuitBreaker].
+	// Клиенты пула [Pool] получают собственные выключатели из PoolConfig.CircuitBreaker.
+	CircuitBreaker *CircuitBreaker
+
+	// Цепочка [Middleware], через которую выполняются все запросы клиента.
+	// Первая middleware в списке выполняется первой.
+	Middlewares []Middleware
+
+	// Провайдер трассировки OpenTelemetry.
+	//
+	// Для каждого HTTP-запроса создаётся спан с типом сущности, методом, статусом ответа,
+	// заголовками ограничений и кодами ошибок API. Массовые операции создают родительские спаны
+	// для каждой страницы и каждой порции объектов.
+	//
+	// Если не указан, используется глобальный провайдер [otel.GetTracerProvider].
+	TracerProvider trace.TracerProvider
+
+	// Получатель метрик клиента: количество и длительность запросов, запас ограничений,
+	// время ожидания ограничителя, повторы, выполняемые запросы и проверки асинхронных задач.
+	//
+	// Готовая реализация для Prometheus находится в пакете prommetrics.
+	Metrics Metrics
+
+	// Журнал внутренней диагностики клиента: ошибки отдельных страниц и порций массовых операций,
+	// ошибки разбора ответов и тела запросов при установленном флаге LogBodies.
+	//
+	// Если не указан, используется [slog.Default]. Вспомогательные методы, не связанные с клиентом
+	// (например, [Diff.GetSalesPrices]), всегда используют [slog.Default].
+	// Чтобы отключить журнал, передайте журнал с обработчиком, не выводящим записи.
+	Logger *slog.Logger
+
+	// Устанавливает флаг, который включает запись тел запросов и ответов в журнал Logger с уровнем [slog.LevelDebug].
+	//
+	// Заголовки авторизации и токены в теле заменяются на «[REDACTED]».
+	LogBodies bool
+
+	// Кэширование ответов на запросы справочников (валюты, единицы измерения, склады и т.д.).
+	//
+	// Если не указано, ответы не кэшируются. См. [CacheConfig].
+	Cache *CacheConfig
+
+	// Устанавливает флаг, который включает объединение одновременных одинаковых GET-запросов.
+	//
+	// Одновременные GET-запросы с одинаковыми путём, параметрами и авторизацией
+	// выполняются однократно, а каждый вызов получает собственную копию результата.
+	// По умолчанию объединение отключено.
+	EnableRequestCoalescing bool
+
+	// Устанавливает флаг, который включает режим пробного запуска.
+	//
+	// В режиме пробного запуска изменяющие запросы (POST, PUT, DELETE) не отправляются, а записываются
+	// в журнал [Client.DryRunJournal]; в качестве результата возвращается переданный объект.
+	// GET-запросы и запросы без побочных эффектов (отчёты, автозаполнение, печать, шаблоны документов)
+	// выполняются как обычно. Режим можно изменить методом [Client.SetDryRun]
+	// или для отдельного контекста с помощью [ContextWithDryRun].
+	DryRun bool
+
+	// Устанавливает флаг, который включает передачу неизвестных полей JSON в запросах.
+	//
+	// Поля ответа, не описанные в структурах библиотеки, всегда сохраняются в объектах
+	// и доступны методом UnknownFields. Если флаг установлен, объекты, полученные клиентом,
+	// передают эти поля при сериализации, поэтому изменение объекта, полученного методом GetByID,
+	// методом Update не удаляет поля, добавленные в API после выхода версии библиотеки.
+	// Для отдельного объекта передачу можно включить методом KeepUnknownFields.
+	PreserveUnknownFields bool
+
+	// Обработчик расхождений ответов API со структурами библиотеки.
+	//
+	// Если указан, каждый успешный ответ сопоставляется с типом результата: обработчик вызывается
+	// для полей, не описанных в структурах, и для значений перечислений ([MetaType], [AttributeType],
+	// [TrackingType], [TaxSystem] и др.), отсутствующих в библиотеке. Каждое расхождение сообщается
+	// один раз за ответ; запрос при этом завершается как обычно.
+	// Обработчик вызывается синхронно и увеличивает время разбора ответа, поэтому предназначен для диагностики.
+	OnSchemaDrift func(drift SchemaDrift)
+
+	// Часовой пояс учётной записи.
+	//
+	// API передаёт дату и время без смещения, в часовом поясе учётной записи. Клиент переводит в него
+	// значения [Timestamp] в ответах и телах запросов, а также параметры [WithMomentFrom], [WithMomentTo]
+	// и [WithFilterMoment]. Если не указан, используется [DefaultLocation] (Europe/Moscow).
+	Location *time.Location
 }
 
 // apply применяет конфигурацию к клиенту.
 func (config Config) apply(client *Client) {
-	if config.HTTPClient != nil {
-		client.Client = resty.NewWithClient(config.HTTPClient)
-	}
-
-	if config.RestyClient != nil {
+	switch {
+	case config.RestyClient != nil:
 		client.Client = config.RestyClient
-	} else {
+	case config.HTTPClient != nil:
+		client.Client = resty.NewWithClient(config.HTTPClient)
+	default:
 		client.Client = resty.New()
 	}
 
-	if config.Username != "" && config.Password != "" {
-		client.SetBasicAuth(config.Username, config.Password)
-	}
-
-	if config.Token != "" {
+	switch {
+	case config.TokenProvider != nil:
+		client.SetTokenProvider(config.TokenProvider)
+	case config.Token != "":
 		client.SetAuthToken(config.Token)
+	case config.Username != "" && config.Password != "":
+		client.SetTokenProvider(NewCredentialsTokenProvider(client, config.Username, config.Password))
 	}
 
 	if config.DisabledWebhookContent {
@@ -113,8 +244,59 @@ func (config Config) apply(client *Client) {
 		}
 	}
 
+	client.retryPolicy = config.RetryPolicy
+
+	client.middlewares = config.Middlewares
+
+	tracerProvider := config.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	client.tracer = tracerProvider.Tracer(instrumentationName, trace.WithInstrumentationVersion(Version))
+
+	client.metrics = config.Metrics
+	if client.metrics == nil {
+		client.metrics = noopMetrics{}
+	}
+
+	client.logger = config.Logger
+	if client.logger == nil {
+		client.logger = slog.Default()
+	}
+	client.logBodies = config.LogBodies
+
+	client.preserveUnknownFields = config.PreserveUnknownFields
+	client.onSchemaDrift = config.OnSchemaDrift
+
+	client.location = config.Location
+	if client.location == nil {
+		client.location = DefaultLocation
+	}
+
+	client.journal = &DryRunJournal{}
+	client.dryRun.Store(config.DryRun)
+
+	if config.EnableRequestCoalescing {
+		client.flights = newFlightGroup(client)
+	}
+
+	if config.Cache != nil {
+		client.cache = newResponseCache(config.Cache)
+	}
+
+	client.breaker = config.CircuitBreaker
+
+	client.limiter = config.Limiter
+	if client.limiter == nil {
+		client.limiter = DefaultLimiter()
+	}
+
 	// устанавливаем базовый URL
-	client.SetBaseURL(baseApiURL)
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = baseApiURL
+	}
+	client.SetBaseURL(baseURL)
 
 	// устанавливаем необходимые заголовки
 	client.Header.Set("Accept", "application/json;charset=utf-8")
@@ -124,7 +306,7 @@ func (config Config) apply(client *Client) {
 
 // New создает новый экземпляр клиента.
 //
-// Принимает аргумент [Config], в котором необходимо указывать либо токен (в приоритете), либо логин и пароль.
+// Принимает аргумент [Config], в котором необходимо указывать либо источник токена, либо токен, либо логин и пароль.
 //
 // # Пример:
 //
@@ -133,31 +315,14 @@ func (config Config) apply(client *Client) {
 //		DisabledWebhookContent: true,
 //	})
 func New(config Config) *Client {
-	client := &Client{
-		limits: &queryLimits{
-			// количество запросов за 3-х секундный период и количество параллельных запросов.
-			rl:       ratelimit.New(MaxQueriesPerSecond),
-			queryBuf: make(chan struct{}, MaxQueriesPerUser),
-		},
-	}
+	client := &Client{}
 
 	config.apply(client)
 
 	return client
 }
 
-// queryLimits используется для ограничения количества параллельных запросов
-// и минимального интервала между ними.
-type queryLimits struct {
-	rl       ratelimit.Limiter // Лимитатор для контроля частоты запросов
-	queryBuf chan struct{}     // Буфер для управления параллельными запросами
-}
-
-func (queryLimits *queryLimits) Wait() {
-	queryLimits.queryBuf <- struct{}{}
-	queryLimits.rl.Take()
-}
-
-func (queryLimits *queryLimits) Done() {
-	<-queryLimits.queryBuf
+// Location возвращает часовой пояс учётной записи, в котором клиент передаёт и получает дату и время.
+func (client *Client) Location() *time.Location {
+	return client.location
 }
@@ -0,0 +1,338 @@
+package moyskladtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	attributesPath = "/metadata/attributes/" // Часть ссылки на доп. поле
+
+	maxLimit       = 1000 // Максимальное количество объектов на странице
+	maxExpandLimit = 100  // Максимальное количество объектов на странице, при котором выполняется expand
+)
+
+// condition условие фильтрации.
+type condition struct {
+	key      string
+	operator string
+	value    string
+}
+
+// filterOperators операторы фильтрации в порядке сопоставления.
+var filterOperators = []string{">=", "<=", "!=", "~=", "=~", "!~", "=", ">", "<", "~"}
+
+// parseFilter разбирает значение параметра filter.
+func parseFilter(filter string) ([]condition, error) {
+	var conditions []condition
+
+	for _, part := range strings.Split(filter, ";") {
+		if part == "" {
+			continue
+		}
+
+		// в ссылке на доп. поле оператор ищется после ID доп. поля
+		var start int
+		if at := strings.Index(part, attributesPath); strings.HasPrefix(part, "http") && at > 0 {
+			start = at + len(attributesPath)
+		}
+
+		index := strings.IndexAny(part[start:], "=!<>~")
+		if index < 0 || start+index == 0 {
+			return nil, fmt.Errorf("неверное условие фильтрации '%s'", part)
+		}
+		index += start
+
+		for _, operator := range filterOperators {
+			if strings.HasPrefix(part[index:], operator) {
+				conditions = append(conditions, condition{
+					key:      part[:index],
+					operator: operator,
+					value:    part[index+len(operator):],
+				})
+				break
+			}
+		}
+	}
+
+	return conditions, nil
+}
+
+// matchFilter возвращает «true», если объект item удовлетворяет условиям conditions.
+// Несколько условий равенства одного поля объединяются через «или», остальные – через «и».
+func matchFilter(item object, conditions []condition) bool {
+	equals := make(map[string][]string)
+
+	for _, cond := range conditions {
+		if cond.operator == "=" {
+			equals[cond.key] = append(equals[cond.key], cond.value)
+			continue
+		}
+
+		if !compareCondition(fieldValue(item, cond.key), cond) {
+			return false
+		}
+	}
+
+	for key, values := range equals {
+		field := fieldValue(item, key)
+		if !slices.ContainsFunc(values, func(value string) bool {
+			return compareCondition(field, condition{key: key, operator: "=", value: value})
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldValue возвращает значение поля key объекта item.
+// Ключ может быть ссылкой на доп. поле или путём через точку.
+func fieldValue(item object, key string) any {
+	if strings.HasPrefix(key, "http") {
+		id := idFromHref(key)
+		attributes, _ := item["attributes"].([]any)
+		for _, attribute := range attributes {
+			if attr, ok := attribute.(object); ok && (attr["id"] == id || hrefID(attr) == id) {
+				return attr["value"]
+			}
+		}
+		return nil
+	}
+
+	var value any = item
+	for _, part := range strings.Split(key, ".") {
+		current, ok := value.(object)
+		if !ok {
+			return nil
+		}
+		value = current[part]
+	}
+	return value
+}
+
+// compareCondition сравнивает значение поля field со значением условия cond.
+func compareCondition(field any, cond condition) bool {
+	// ссылки на объекты сравниваются по ID
+	if obj, ok := field.(object); ok {
+		field = hrefID(obj)
+		if strings.Contains(cond.value, "/") {
+			cond.value = idFromHref(cond.value)
+		}
+	}
+
+	if field == nil {
+		switch cond.operator {
+		case "=":
+			return cond.value == ""
+		case "!=":
+			return cond.value != ""
+		default:
+			return false
+		}
+	}
+
+	if cond.value == "" && (cond.operator == "=" || cond.operator == "!=") {
+		return cond.operator == "!="
+	}
+
+	text := valueString(field)
+	lowerText, lowerValue := strings.ToLower(text), strings.ToLower(cond.value)
+
+	switch cond.operator {
+	case "~":
+		return strings.Contains(lowerText, lowerValue)
+	case "!~":
+		return !strings.Contains(lowerText, lowerValue)
+	case "~=":
+		return strings.HasPrefix(lowerText, lowerValue)
+	case "=~":
+		return strings.HasSuffix(lowerText, lowerValue)
+	}
+
+	result := compareValues(field, cond.value)
+
+	switch cond.operator {
+	case "=":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">":
+		return result > 0
+	case "<":
+		return result < 0
+	case ">=":
+		return result >= 0
+	case "<=":
+		return result <= 0
+	}
+	return false
+}
+
+// compareValues сравнивает значение поля field со строкой value как числа или как строки.
+func compareValues(field any, value string) int {
+	if number, ok := numberValue(field); ok {
+		if other, err := strconv.ParseFloat(value, 64); err == nil {
+			switch {
+			case number < other:
+				return -1
+			case number > other:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	text := valueString(field)
+
+	// моменты сравниваются с точностью, указанной в условии
+	if len(value) < len(text) && looksLikeTimestamp(text) && looksLikeTimestamp(value) {
+		text = text[:len(value)]
+	}
+
+	return strings.Compare(text, value)
+}
+
+func looksLikeTimestamp(value string) bool {
+	return len(value) >= len("2006-01-02") && value[4] == '-' && value[7] == '-'
+}
+
+func numberValue(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func valueString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case object:
+		return hrefID(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// matchSearch возвращает «true», если строковые поля объекта item содержат строку search.
+func matchSearch(item object, search string) bool {
+	search = strings.ToLower(search)
+	for key, value := range item {
+		if key == "id" || key == "accountId" {
+			continue
+		}
+		if s, ok := value.(string); ok && strings.Contains(strings.ToLower(s), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortRows упорядочивает объекты rows по значению параметра order, например «name;updated,desc».
+func sortRows(rows []object, order string) {
+	type orderField struct {
+		key  string
+		desc bool
+	}
+
+	var fields []orderField
+	for _, part := range strings.Split(order, ";") {
+		key, direction, _ := strings.Cut(part, ",")
+		if key != "" {
+			fields = append(fields, orderField{key: key, desc: direction == "desc"})
+		}
+	}
+
+	slices.SortStableFunc(rows, func(a, b object) int {
+		for _, field := range fields {
+			result := compareFields(fieldValue(a, field.key), fieldValue(b, field.key))
+			if field.desc {
+				result = -result
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+}
+
+func compareFields(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if x, ok := numberValue(a); ok {
+		if y, ok := numberValue(b); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	return strings.Compare(valueString(a), valueString(b))
+}
+
+// page параметры страницы списка.
+type page struct {
+	limit  int
+	offset int
+}
+
+// parsePage разбирает параметры limit и offset.
+func parsePage(query url.Values) (page, error) {
+	p := page{limit: maxLimit}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > maxLimit {
+			return p, fmt.Errorf("параметр 'limit' должен быть целым числом от 1 до %d", maxLimit)
+		}
+		p.limit = limit
+	}
+
+	if value := query.Get("offset"); value != "" {
+		offset, err := strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			return p, fmt.Errorf("параметр 'offset' должен быть неотрицательным целым числом")
+		}
+		p.offset = offset
+	}
+
+	return p, nil
+}
+
+// pageHref возвращает ссылку на страницу списка коллекции c с параметрами query.
+func pageHref(c *collection, query url.Values, limit, offset int) string {
+	query = maps.Clone(query)
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+	return c.base + c.path + "?" + query.Encode()
+}
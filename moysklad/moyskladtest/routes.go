@@ -0,0 +1,375 @@
+package moyskladtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// route выполняет запрос method к пути path. Вызывается под блокировкой.
+func (server *Server) route(method, path string, query url.Values, body any) response {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "entity" {
+		return errorResponse(http.StatusNotFound, notSupportedError(method, path))
+	}
+
+	entityType, rest := segments[1], segments[2:]
+	entities := server.entities(entityType)
+
+	switch {
+	case len(rest) == 0:
+		return server.collectionRequest(entities, method, query, body)
+
+	case len(rest) == 1 && rest[0] == "delete" && method == http.MethodPost:
+		return server.deleteMany(entities, body)
+
+	case rest[0] == "metadata":
+		return server.metadataRequest(entityType, method, rest[1:], query, body)
+
+	case len(rest) == 2 && rest[0] == "syncid":
+		item, ok := entities.findBy("syncId", rest[1])
+		if !ok {
+			return errorResponse(http.StatusNotFound, notFoundError(rest[1]))
+		}
+		return server.itemRequest(entities, item["id"].(string), method, query, body)
+
+	case len(rest) == 1:
+		return server.itemRequest(entities, rest[0], method, query, body)
+
+	case len(rest) == 2 && rest[1] == "trash" && method == http.MethodPost:
+		return server.trash(entities, rest[0])
+
+	case rest[1] == "positions":
+		if _, ok := entities.items[rest[0]]; !ok {
+			return errorResponse(http.StatusNotFound, notFoundError(rest[0]))
+		}
+
+		positions := server.store.positions(entities, rest[0])
+		defer server.touchPositions(entities, rest[0], positions)
+
+		switch {
+		case len(rest) == 2:
+			return server.collectionRequest(positions, method, query, body)
+		case len(rest) == 3 && rest[2] == "delete" && method == http.MethodPost:
+			return server.deleteMany(positions, body)
+		case len(rest) == 3:
+			return server.itemRequest(positions, rest[2], method, query, body)
+		}
+	}
+
+	return errorResponse(http.StatusNotFound, notSupportedError(method, path))
+}
+
+// metadataRequest выполняет запрос к метаданным сущности entityType.
+func (server *Server) metadataRequest(entityType, method string, rest []string, query url.Values, body any) response {
+	if len(rest) == 0 {
+		if method != http.MethodGet {
+			return errorResponse(http.StatusMethodNotAllowed, notSupportedError(method, "entity/"+entityType+"/metadata"))
+		}
+		return response{status: http.StatusOK, body: server.metadata(entityType)}
+	}
+
+	var c *collection
+	switch rest[0] {
+	case "attributes":
+		c = server.store.collection("entity/"+entityType+"/metadata/attributes", "attributemetadata")
+	case "states":
+		c = server.store.collection("entity/"+entityType+"/metadata/states", "state")
+	default:
+		return errorResponse(http.StatusNotFound, notSupportedError(method, "entity/"+entityType+"/metadata/"+strings.Join(rest, "/")))
+	}
+
+	switch {
+	case len(rest) == 1:
+		return server.collectionRequest(c, method, query, body)
+	case len(rest) == 2 && rest[1] == "delete" && method == http.MethodPost:
+		return server.deleteMany(c, body)
+	case len(rest) == 2:
+		return server.itemRequest(c, rest[1], method, query, body)
+	}
+
+	return errorResponse(http.StatusNotFound, notSupportedError(method, c.path+"/"+strings.Join(rest[1:], "/")))
+}
+
+// metadata возвращает метаданные сущности entityType.
+func (server *Server) metadata(entityType string) object {
+	path := "entity/" + entityType + "/metadata"
+
+	attributes := server.store.collection(path+"/attributes", "attributemetadata")
+	states := server.store.collection(path+"/states", "state")
+
+	stateRows := make([]any, 0, len(states.ids))
+	for _, state := range states.list() {
+		stateRows = append(stateRows, server.render(states, state, nil))
+	}
+
+	return object{
+		"meta":         object{"href": server.store.base + path, "mediaType": mediaType},
+		"attributes":   collectionMeta(attributes, len(attributes.ids)),
+		"states":       stateRows,
+		"createShared": false,
+	}
+}
+
+// collectionRequest выполняет запрос к коллекции c: получение списка или создание объектов.
+func (server *Server) collectionRequest(c *collection, method string, query url.Values, body any) response {
+	switch method {
+	case http.MethodGet:
+		return server.list(c, query)
+	case http.MethodPost:
+		return server.create(c, query, body)
+	}
+	return errorResponse(http.StatusMethodNotAllowed, notSupportedError(method, c.path))
+}
+
+// itemRequest выполняет запрос к объекту коллекции c с ID id.
+func (server *Server) itemRequest(c *collection, id, method string, query url.Values, body any) response {
+	item, ok := c.items[id]
+	if !ok {
+		return errorResponse(http.StatusNotFound, notFoundError(id))
+	}
+
+	switch method {
+	case http.MethodGet:
+		return response{status: http.StatusOK, body: server.render(c, item, expandPaths(query))}
+
+	case http.MethodPut:
+		fields, ok := body.(object)
+		if !ok {
+			return errorResponse(http.StatusBadRequest, validationError("", "Тело запроса должно быть объектом"))
+		}
+		item, _ = server.store.update(c, id, fields)
+		return response{status: http.StatusOK, body: server.render(c, item, expandPaths(query))}
+
+	case http.MethodDelete:
+		server.store.delete(c, id)
+		return response{status: http.StatusOK}
+	}
+
+	return errorResponse(http.StatusMethodNotAllowed, notSupportedError(method, c.href(id)))
+}
+
+// create создаёт объект или выполняет массовое создание и изменение объектов коллекции c.
+func (server *Server) create(c *collection, query url.Values, body any) response {
+	expand := expandPaths(query)
+
+	switch value := body.(type) {
+	case object:
+		item := server.store.create(c, value)
+		return response{status: http.StatusOK, body: server.render(c, item, expand)}
+
+	case []any:
+		rows := make([]any, 0, len(value))
+		for i, element := range value {
+			fields, ok := element.(object)
+			if !ok {
+				return errorResponse(http.StatusBadRequest, validationError(fmt.Sprintf("[%d]", i), "Элемент массива должен быть объектом"))
+			}
+			rows = append(rows, server.render(c, server.store.upsert(c, fields), expand))
+		}
+		return response{status: http.StatusOK, body: rows}
+	}
+
+	return errorResponse(http.StatusBadRequest, validationError("", "Тело запроса должно быть объектом или массивом объектов"))
+}
+
+// deleteMany удаляет объекты коллекции c, метаданные которых переданы в теле запроса.
+func (server *Server) deleteMany(c *collection, body any) response {
+	elements, ok := body.([]any)
+	if !ok {
+		return errorResponse(http.StatusBadRequest, validationError("", "Тело запроса должно быть массивом метаданных"))
+	}
+
+	rows := make([]object, 0, len(elements))
+	for _, element := range elements {
+		fields, _ := element.(object)
+		id := hrefID(fields)
+
+		if id == "" || !server.store.delete(c, id) {
+			rows = append(rows, errorResponse(http.StatusNotFound, notFoundError(id)).body.(object))
+			continue
+		}
+
+		rows = append(rows, object{"info": fmt.Sprintf("Сущность '%s' с UUID: %s успешно удалена", c.itemType, id)})
+	}
+
+	return response{status: http.StatusOK, body: rows}
+}
+
+// trash перемещает объект коллекции c с ID id в корзину.
+func (server *Server) trash(c *collection, id string) response {
+	if _, ok := c.items[id]; !ok {
+		return errorResponse(http.StatusNotFound, notFoundError(id))
+	}
+
+	server.store.update(c, id, object{"deleted": server.options.Now().Format(timestampFormat)})
+	return response{status: http.StatusOK}
+}
+
+// touchPositions обновляет количество позиций в документе после запроса к позициям.
+func (server *Server) touchPositions(documents *collection, id string, positions *collection) {
+	if document, ok := documents.items[id]; ok {
+		document["positions"] = collectionMeta(positions, len(positions.ids))
+	}
+}
+
+// list возвращает страницу списка объектов коллекции c.
+func (server *Server) list(c *collection, query url.Values) response {
+	p, err := parsePage(query)
+	if err != nil {
+		return errorResponse(http.StatusBadRequest, validationError("limit", err.Error()))
+	}
+
+	conditions, err := parseFilter(query.Get("filter"))
+	if err != nil {
+		return errorResponse(http.StatusBadRequest, validationError("filter", err.Error()))
+	}
+
+	// объекты в корзине выводятся только при фильтре isDeleted=true
+	deleted := slices.ContainsFunc(conditions, func(cond condition) bool {
+		return cond.key == "isDeleted" && cond.value == "true"
+	})
+	conditions = slices.DeleteFunc(conditions, func(cond condition) bool {
+		return cond.key == "isDeleted"
+	})
+
+	search := query.Get("search")
+
+	var rows []object
+	for _, item := range c.list() {
+		if _, inTrash := item["deleted"]; inTrash != deleted {
+			continue
+		}
+		if !matchFilter(item, conditions) || search != "" && !matchSearch(item, search) {
+			continue
+		}
+		rows = append(rows, item)
+	}
+
+	if order := query.Get("order"); order != "" {
+		sortRows(rows, order)
+	}
+
+	size := len(rows)
+	rows = rows[min(p.offset, size):min(p.offset+p.limit, size)]
+
+	// при limit больше 100 вложенные объекты не раскрываются
+	var expand []string
+	if p.limit <= maxExpandLimit {
+		expand = expandPaths(query)
+	}
+
+	rendered := make([]object, 0, len(rows))
+	for _, item := range rows {
+		rendered = append(rendered, server.render(c, item, expand))
+	}
+
+	meta := object{
+		"href":      pageHref(c, query, p.limit, p.offset),
+		"type":      c.itemType,
+		"mediaType": mediaType,
+		"size":      size,
+		"limit":     p.limit,
+		"offset":    p.offset,
+	}
+
+	if p.offset+p.limit < size {
+		meta["nextHref"] = pageHref(c, query, p.limit, p.offset+p.limit)
+	}
+
+	if p.offset > 0 {
+		meta["previousHref"] = pageHref(c, query, p.limit, max(p.offset-p.limit, 0))
+	}
+
+	return response{status: http.StatusOK, body: object{"meta": meta, "rows": rendered}}
+}
+
+// expandPaths возвращает пути раскрываемых полей из параметра expand.
+func expandPaths(query url.Values) []string {
+	var paths []string
+	for _, value := range query["expand"] {
+		for _, path := range strings.Split(value, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// render возвращает копию объекта item коллекции c с раскрытыми полями expand.
+func (server *Server) render(c *collection, item object, expand []string) object {
+	rendered := clone(item).(object)
+
+	if _, ok := rendered["positions"]; ok {
+		positions := server.store.positions(c, rendered["id"].(string))
+		rendered["positions"] = collectionMeta(positions, len(positions.ids))
+	}
+
+	server.expand(rendered, expand)
+	return rendered
+}
+
+// expand раскрывает поля paths объекта value: ссылки на объекты заменяются объектами,
+// а ссылки на коллекции (например, позиции) дополняются списком объектов.
+func (server *Server) expand(value object, paths []string) {
+	nested := make(map[string][]string)
+	for _, path := range paths {
+		field, rest, _ := strings.Cut(path, ".")
+		if _, ok := nested[field]; !ok {
+			nested[field] = nil
+		}
+		if rest != "" {
+			nested[field] = append(nested[field], rest)
+		}
+	}
+
+	for field, rest := range nested {
+		switch fieldValue := value[field].(type) {
+		case object:
+			value[field] = server.expandObject(fieldValue, rest)
+		case []any:
+			for i, element := range fieldValue {
+				if obj, ok := element.(object); ok {
+					fieldValue[i] = server.expandObject(obj, rest)
+				}
+			}
+		}
+	}
+}
+
+// expandObject возвращает объект или коллекцию, на которую ссылается value.
+func (server *Server) expandObject(value object, rest []string) object {
+	meta, _ := value["meta"].(object)
+	href, _ := meta["href"].(string)
+	path := pathFromHref(href)
+
+	if c, ok := server.store.lookup(path); ok {
+		rows := make([]any, 0, len(c.ids))
+		for _, item := range c.list() {
+			rows = append(rows, server.render(c, item, rest))
+		}
+		expanded := clone(value).(object)
+		expanded["rows"] = rows
+		return expanded
+	}
+
+	index := strings.LastIndex(path, "/")
+	if index < 0 {
+		return value
+	}
+
+	c, ok := server.store.lookup(path[:index])
+	if !ok {
+		return value
+	}
+
+	item, ok := c.items[path[index+1:]]
+	if !ok {
+		return value
+	}
+
+	return server.render(c, item, rest)
+}
@@ -0,0 +1,480 @@
+// Package moyskladtest содержит тестовый сервер, эмулирующий JSON API 1.2 МойСклад в памяти.
+//
+// Сервер позволяет проверять код, использующий [moysklad.Client], без обращения к реальной учётной записи.
+// Поддерживаются:
+//   - создание, получение, изменение и удаление объектов любых сущностей (entity/<тип>),
+//     в том числе массовое создание и изменение и массовое удаление (entity/<тип>/delete);
+//   - получение и удаление по syncId (entity/<тип>/syncid/<syncId>) и перемещение в корзину;
+//   - позиции документов (entity/<тип>/<id>/positions);
+//   - метаданные: доп. поля (metadata/attributes) и статусы (metadata/states);
+//   - параметры списков limit, offset, filter, search, order и expand, поле meta.size и ссылки на страницы;
+//   - заголовки ограничений X-RateLimit-*, ответы 429 и ошибки в формате API;
+//   - получение токена по логину и паролю (security/token).
+//
+// Ссылки в ответах указывают на адрес тестового сервера ([Server.BaseURL]),
+// поэтому клиент выполняет запросы по ним (следующие страницы, [moysklad.FetchMeta]) к тестовому серверу.
+// Ссылки на api.moysklad.ru в теле запросов также принимаются.
+//
+// # Пример:
+//
+//	func TestOrders(t *testing.T) {
+//		server := moyskladtest.New(t)
+//		server.Add(moysklad.MetaTypeCustomerOrder, &moysklad.CustomerOrder{Name: moysklad.String("00001")})
+//
+//		client := server.Client(moysklad.Config{})
+//
+//		orders, _, err := client.Entity().CustomerOrder().GetList(context.Background())
+//		...
+//	}
+package moyskladtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+)
+
+const (
+	apiPath = "/api/remap/1.2/" // Путь API на тестовом сервере
+
+	// DefaultToken токен, который принимает сервер, если в [Options] не указан другой.
+	DefaultToken = "moyskladtest-token"
+)
+
+// Options параметры тестового сервера.
+type Options struct {
+	// Токен доступа. Запросы с другим токеном завершаются ошибкой 401.
+	// По умолчанию [DefaultToken].
+	Token string
+
+	// Логин и пароль, по которым выдаётся токен (security/token).
+	// Если не указаны, токен выдаётся по любым данным.
+	Username string
+	Password string
+
+	// Количество запросов за период RateLimitWindow, отражаемое в заголовках X-RateLimit-*.
+	// По умолчанию 45 запросов за 3 секунды.
+	RateLimit       int
+	RateLimitWindow time.Duration
+
+	// Устанавливает флаг, который включает ответы 429 при превышении ограничения RateLimit.
+	EnforceRateLimit bool
+
+	// Источник текущего времени для полей created и updated. По умолчанию [time.Now] в часовом поясе Москвы.
+	Now func() time.Time
+}
+
+// Request запрос, полученный сервером.
+type Request struct {
+	Method string
+	Path   string     // Путь относительно базового адреса API, например entity/product
+	Query  url.Values // Параметры запроса
+	Body   []byte     // Тело запроса
+}
+
+// failure ответ, который сервер вернёт вместо обработки следующего запроса.
+type failure struct {
+	statusCode int
+	errors     []moysklad.ApiError
+	header     http.Header
+}
+
+// Server тестовый сервер МойСклад.
+//
+// Безопасен для использования из нескольких горутин.
+type Server struct {
+	*httptest.Server
+
+	options     Options
+	store       *store
+	requests    []Request
+	failures    []failure
+	windowStart time.Time
+	windowCount int
+	mu          sync.Mutex
+}
+
+// New запускает тестовый сервер и останавливает его по завершении теста t.
+func New(t testing.TB, options ...Options) *Server {
+	t.Helper()
+
+	var opts Options
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	server := NewServer(opts)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// NewServer запускает тестовый сервер. Сервер необходимо остановить методом Close.
+func NewServer(options Options) *Server {
+	if options.Token == "" {
+		options.Token = DefaultToken
+	}
+	if options.RateLimit <= 0 {
+		options.RateLimit = moysklad.MaxQueriesPerSecond * 3
+	}
+	if options.RateLimitWindow <= 0 {
+		options.RateLimitWindow = 3 * time.Second
+	}
+	if options.Now == nil {
+		location, err := time.LoadLocation("Europe/Moscow")
+		if err != nil {
+			location = time.FixedZone("MSK", 3*60*60)
+		}
+		options.Now = func() time.Time { return time.Now().In(location) }
+	}
+
+	server := &Server{options: options}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	server.store = newStore(server.BaseURL(), options.Now)
+	return server
+}
+
+// BaseURL возвращает базовый адрес API тестового сервера.
+func (server *Server) BaseURL() string {
+	return server.Server.URL + apiPath
+}
+
+// Client возвращает клиент, выполняющий запросы к тестовому серверу.
+//
+// Если в конфигурации не указаны источник токена, токен, логин и пароль, используется токен сервера.
+// Если не указан базовый адрес, используется адрес тестового сервера.
+func (server *Server) Client(config moysklad.Config) *moysklad.Client {
+	if config.TokenProvider == nil && config.Token == "" && config.Username == "" {
+		config.Token = server.options.Token
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = server.BaseURL()
+	}
+
+	return moysklad.New(config)
+}
+
+// Add сохраняет объект entity сущности metaType и возвращает его ID.
+// Позиции документа из поля positions сохраняются как позиции документа.
+func (server *Server) Add(metaType moysklad.MetaType, entity any) string {
+	item, err := toObject(entity)
+	if err != nil {
+		panic(fmt.Sprintf("moyskladtest: %v", err))
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	stored := server.store.create(server.entities(metaType.String()), item)
+	return stored["id"].(string)
+}
+
+// Get декодирует сохранённый объект сущности metaType с ID id в target.
+// Возвращает «false», если объект не найден.
+func (server *Server) Get(metaType moysklad.MetaType, id string, target any) bool {
+	server.mu.Lock()
+	item, ok := server.entities(metaType.String()).items[id]
+	if ok {
+		item = server.render(server.entities(metaType.String()), item, nil)
+	}
+	server.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		panic(fmt.Sprintf("moyskladtest: %v", err))
+	}
+	return json.Unmarshal(data, target) == nil
+}
+
+// Count возвращает количество сохранённых объектов сущности metaType.
+func (server *Server) Count(metaType moysklad.MetaType) int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	return len(server.entities(metaType.String()).ids)
+}
+
+// Requests возвращает запросы, полученные сервером.
+func (server *Server) Requests() []Request {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	return append([]Request(nil), server.requests...)
+}
+
+// FailNext устанавливает ответ со статусом statusCode и ошибками apiErrors на следующие count запросов.
+func (server *Server) FailNext(count, statusCode int, apiErrors ...moysklad.ApiError) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	for range count {
+		server.failures = append(server.failures, failure{statusCode: statusCode, errors: apiErrors})
+	}
+}
+
+// RateLimitNext устанавливает ответ 429 с ошибкой 1049 и заголовком X-Lognex-Retry-After
+// со значением retryAfter на следующие count запросов.
+func (server *Server) RateLimitNext(count int, retryAfter time.Duration) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	header := http.Header{}
+	header.Set("X-Lognex-Retry-After", strconv.FormatInt(retryAfter.Milliseconds(), 10))
+
+	for range count {
+		server.failures = append(server.failures, failure{
+			statusCode: http.StatusTooManyRequests,
+			errors:     []moysklad.ApiError{rateLimitError()},
+			header:     header,
+		})
+	}
+}
+
+// Reset удаляет все объекты, полученные запросы и запланированные ошибки.
+func (server *Server) Reset() {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.store = newStore(server.BaseURL(), server.options.Now)
+	server.requests = nil
+	server.failures = nil
+}
+
+// entities возвращает коллекцию объектов сущности entityType. Вызывается под блокировкой.
+func (server *Server) entities(entityType string) *collection {
+	return server.store.collection("entity/"+entityType, entityType)
+}
+
+// response ответ сервера.
+type response struct {
+	status int
+	body   any
+	header http.Header
+}
+
+func (server *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	path := strings.TrimPrefix(r.URL.Path, apiPath)
+	query := r.URL.Query()
+
+	server.mu.Lock()
+	server.requests = append(server.requests, Request{Method: r.Method, Path: path, Query: query, Body: body})
+	resp := server.handle(r, path, query, body)
+	server.mu.Unlock()
+
+	for key, values := range resp.header {
+		w.Header()[key] = values
+	}
+
+	if resp.body == nil {
+		w.WriteHeader(resp.status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(resp.status)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(resp.body)
+}
+
+// handle обрабатывает запрос. Вызывается под блокировкой.
+func (server *Server) handle(r *http.Request, path string, query url.Values, body []byte) (resp response) {
+	header := server.rateLimitHeader()
+	defer func() {
+		if resp.header == nil {
+			resp.header = http.Header{}
+		}
+		for key, values := range header {
+			if resp.header.Get(key) == "" {
+				resp.header[key] = values
+			}
+		}
+	}()
+
+	if !strings.HasPrefix(r.URL.Path, apiPath) {
+		return errorResponse(http.StatusNotFound, notSupportedError(r.Method, r.URL.Path))
+	}
+
+	if path == "security/token" && r.Method == http.MethodPost {
+		return server.token(r)
+	}
+
+	if !server.authorized(r) {
+		return errorResponse(http.StatusUnauthorized, moysklad.ApiError{
+			Header: "Ошибка аутентификации: Неправильный пароль или имя пользователя или ключ авторизации",
+			Code:   moysklad.ErrorCodeAuthentication,
+		})
+	}
+
+	if len(server.failures) > 0 {
+		f := server.failures[0]
+		server.failures = server.failures[1:]
+
+		resp = errorResponse(f.statusCode, f.errors...)
+		resp.header = f.header.Clone()
+		return resp
+	}
+
+	if server.options.EnforceRateLimit && server.windowCount > server.options.RateLimit {
+		resp = errorResponse(http.StatusTooManyRequests, rateLimitError())
+		resp.header = http.Header{}
+		resp.header.Set("X-Lognex-Retry-After", header.Get("X-Lognex-Reset"))
+		return resp
+	}
+
+	var value any
+	if len(body) > 0 {
+		var err error
+		if value, err = decodeJSON(body); err != nil {
+			return errorResponse(http.StatusBadRequest, moysklad.ApiError{
+				Header: "Ошибка формата JSON: " + err.Error(),
+				Code:   moysklad.ErrorCodeValidation,
+			})
+		}
+	}
+
+	return server.route(r.Method, path, query, value)
+}
+
+// authorized проверяет токен запроса.
+func (server *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && token == server.options.Token
+}
+
+// token выдаёт токен по логину и паролю.
+func (server *Server) token(r *http.Request) response {
+	username, password, ok := r.BasicAuth()
+	if !ok || server.options.Username != "" && (username != server.options.Username || password != server.options.Password) {
+		return errorResponse(http.StatusUnauthorized, moysklad.ApiError{
+			Header: "Ошибка аутентификации: Неправильный пароль или имя пользователя или ключ авторизации",
+			Code:   moysklad.ErrorCodeAuthentication,
+		})
+	}
+	return response{status: http.StatusCreated, body: object{"access_token": server.options.Token}}
+}
+
+// rateLimitHeader учитывает запрос и возвращает заголовки ограничений. Вызывается под блокировкой.
+func (server *Server) rateLimitHeader() http.Header {
+	now := time.Now()
+	window := server.options.RateLimitWindow
+
+	if now.Sub(server.windowStart) >= window {
+		server.windowStart = now
+		server.windowCount = 0
+	}
+	server.windowCount++
+
+	remaining := max(server.options.RateLimit-server.windowCount, 0)
+
+	var reset time.Duration
+	if remaining == 0 {
+		reset = window - now.Sub(server.windowStart)
+	}
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", strconv.Itoa(server.options.RateLimit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	header.Set("X-Lognex-Retry-TimeInterval", strconv.FormatInt(window.Milliseconds(), 10))
+	header.Set("X-Lognex-Reset", strconv.FormatInt(reset.Milliseconds(), 10))
+	return header
+}
+
+// errorResponse возвращает ответ с ошибками в формате API.
+func errorResponse(status int, apiErrors ...moysklad.ApiError) response {
+	rows := make([]object, 0, len(apiErrors))
+	for _, apiError := range apiErrors {
+		rows = append(rows, errorObject(apiError))
+	}
+	return response{status: status, body: object{"errors": rows}}
+}
+
+// errorObject возвращает ошибку в формате API.
+func errorObject(apiError moysklad.ApiError) object {
+	row := object{"error": apiError.Header}
+	if apiError.Code != 0 {
+		row["code"] = apiError.Code
+		row["moreInfo"] = fmt.Sprintf("https://dev.moysklad.ru/doc/api/remap/1.2/#error_%d", apiError.Code)
+	}
+	if apiError.MoreInfo != "" {
+		row["moreInfo"] = apiError.MoreInfo
+	}
+	if apiError.Parameter != "" {
+		row["parameter"] = apiError.Parameter
+	}
+	if apiError.Message != "" {
+		row["error_message"] = apiError.Message
+	}
+	if apiError.Line != 0 {
+		row["line"] = apiError.Line
+		row["column"] = apiError.Column
+	}
+	if apiError.Meta != nil {
+		row["meta"] = apiError.Meta
+	}
+	if len(apiError.Dependencies) > 0 {
+		row["dependencies"] = apiError.Dependencies
+	}
+	return row
+}
+
+func rateLimitError() moysklad.ApiError {
+	return moysklad.ApiError{
+		Header: "Превышено ограничение на количество запросов в единицу времени",
+		Code:   moysklad.ErrorCodeRateLimit,
+	}
+}
+
+func notFoundError(id string) moysklad.ApiError {
+	return moysklad.ApiError{
+		Header: fmt.Sprintf("Объект с UUID '%s' не найден", id),
+		Code:   moysklad.ErrorCodeEntityNotFound,
+	}
+}
+
+func notSupportedError(method, path string) moysklad.ApiError {
+	return moysklad.ApiError{Header: fmt.Sprintf("moyskladtest: запрос %s %s не поддерживается", method, path)}
+}
+
+func validationError(parameter, message string) moysklad.ApiError {
+	return moysklad.ApiError{
+		Header:    message,
+		Parameter: parameter,
+		Code:      moysklad.ErrorCodeValidation,
+	}
+}
+
+// toObject преобразует значение value в объект JSON.
+func toObject(value any) (object, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok := decoded.(object)
+	if !ok {
+		return nil, fmt.Errorf("значение %T не является объектом", value)
+	}
+	return item, nil
+}
@@ -0,0 +1,73 @@
+package moyskladtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+	"github.com/EnOane/go-moysklad/moysklad/moyskladtest"
+)
+
+func TestCreateGetListDelete(t *testing.T) {
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{})
+	products := client.Entity().Product()
+	ctx := context.Background()
+
+	server.Add(moysklad.MetaTypeProduct, &moysklad.Product{Name: moysklad.String("Карандаш"), Code: moysklad.String("001")})
+
+	created, _, err := products.Create(ctx, new(moysklad.Product).SetName("Ручка").SetCode("002"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetID() == "" || created.GetMeta().GetHref() == "" {
+		t.Fatalf("created product has no id or meta: %v", created)
+	}
+
+	list, _, err := products.GetList(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Meta.Size != 2 || list.Rows.Len() != 2 {
+		t.Errorf("GetList returned %d of %d products, want 2", list.Rows.Len(), list.Meta.Size)
+	}
+
+	filtered, _, err := products.GetList(ctx, moysklad.WithFilterEquals("code", "002"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filtered.Rows.Len() != 1 || filtered.Rows[0].GetName() != "Ручка" {
+		t.Errorf("filtered list = %v, want the created product", filtered.Rows)
+	}
+
+	ok, _, err := products.Delete(ctx, created)
+	if err != nil || !ok {
+		t.Fatalf("Delete = %v, %v", ok, err)
+	}
+	if got := server.Count(moysklad.MetaTypeProduct); got != 1 {
+		t.Errorf("server stores %d products after delete, want 1", got)
+	}
+
+	_, resp, err := products.GetByID(ctx, created.GetID())
+	if err == nil || resp.StatusCode() != http.StatusNotFound {
+		t.Errorf("GetByID of a deleted product = %v, want 404", err)
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{})
+	ctx := context.Background()
+
+	server.FailNext(1, http.StatusBadRequest, moysklad.ApiError{Code: 1000, Header: "ошибка"})
+
+	_, resp, err := client.Entity().Product().GetList(ctx)
+	if err == nil || resp.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("GetList = %v, want error 400", err)
+	}
+
+	if _, _, err = client.Entity().Product().GetList(ctx); err != nil {
+		t.Errorf("GetList after the failure = %v", err)
+	}
+}
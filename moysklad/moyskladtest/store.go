@@ -0,0 +1,301 @@
+package moyskladtest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+)
+
+const (
+	mediaType       = "application/json"
+	timestampFormat = "2006-01-02 15:04:05.000"
+)
+
+// object объект JSON.
+type object = map[string]any
+
+// collection упорядоченная по моменту создания коллекция объектов одного типа.
+type collection struct {
+	base     string // Базовый адрес ссылок в ответах
+	path     string // Путь коллекции относительно базового адреса, например entity/customerorder/<id>/positions
+	itemType string // Тип объектов коллекции
+	ids      []string
+	items    map[string]object
+}
+
+func newCollection(base, path, itemType string) *collection {
+	return &collection{base: base, path: path, itemType: itemType, items: make(map[string]object)}
+}
+
+// href возвращает ссылку на объект коллекции с ID id.
+func (c *collection) href(id string) string {
+	return c.base + c.path + "/" + id
+}
+
+// list возвращает объекты коллекции в порядке создания.
+func (c *collection) list() []object {
+	rows := make([]object, 0, len(c.ids))
+	for _, id := range c.ids {
+		rows = append(rows, c.items[id])
+	}
+	return rows
+}
+
+func (c *collection) put(id string, item object) {
+	if _, ok := c.items[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.items[id] = item
+}
+
+func (c *collection) remove(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+
+	delete(c.items, id)
+	for i, existing := range c.ids {
+		if existing == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// findBy возвращает объект, поле field которого равно value.
+func (c *collection) findBy(field, value string) (object, bool) {
+	for _, id := range c.ids {
+		if s, ok := c.items[id][field].(string); ok && s == value {
+			return c.items[id], true
+		}
+	}
+	return nil, false
+}
+
+// store хранилище коллекций сервера.
+type store struct {
+	base        string // Базовый адрес ссылок в ответах – адрес API тестового сервера
+	accountID   string
+	now         func() time.Time
+	collections map[string]*collection
+}
+
+func newStore(base string, now func() time.Time) *store {
+	return &store{base: base, accountID: newID(), now: now, collections: make(map[string]*collection)}
+}
+
+// collection возвращает коллекцию path, создавая её при необходимости.
+func (s *store) collection(path, itemType string) *collection {
+	c, ok := s.collections[path]
+	if !ok {
+		c = newCollection(s.base, path, itemType)
+		s.collections[path] = c
+	}
+	return c
+}
+
+// lookup возвращает коллекцию path, если она существует.
+func (s *store) lookup(path string) (*collection, bool) {
+	c, ok := s.collections[path]
+	return c, ok
+}
+
+// dropPrefix удаляет коллекции, путь которых начинается с prefix (например, позиции удалённого документа).
+func (s *store) dropPrefix(prefix string) {
+	for path := range s.collections {
+		if strings.HasPrefix(path, prefix) {
+			delete(s.collections, path)
+		}
+	}
+}
+
+// create сохраняет новый объект item в коллекции c.
+func (s *store) create(c *collection, item object) object {
+	id, _ := item["id"].(string)
+	if id == "" {
+		id = newID()
+	}
+
+	stored := s.stamp(c, id, item)
+	stored["created"] = stored["updated"]
+	s.storeNested(c, stored)
+	c.put(id, stored)
+	return stored
+}
+
+// update изменяет поля объекта c с ID id значениями полей item.
+func (s *store) update(c *collection, id string, item object) (object, bool) {
+	existing, ok := c.items[id]
+	if !ok {
+		return nil, false
+	}
+
+	merged := maps.Clone(existing)
+	maps.Copy(merged, item)
+
+	stored := s.stamp(c, id, merged)
+	s.storeNested(c, stored)
+	c.put(id, stored)
+	return stored, true
+}
+
+// upsert создаёт объект или изменяет существующий, если в item указаны его метаданные.
+func (s *store) upsert(c *collection, item object) object {
+	if id := hrefID(item); id != "" {
+		if stored, ok := s.update(c, id, item); ok {
+			return stored
+		}
+	}
+	return s.create(c, item)
+}
+
+// delete удаляет объект c с ID id вместе с вложенными коллекциями.
+func (s *store) delete(c *collection, id string) bool {
+	if !c.remove(id) {
+		return false
+	}
+	s.dropPrefix(c.path + "/" + id + "/")
+	return true
+}
+
+// stamp устанавливает служебные поля объекта.
+func (s *store) stamp(c *collection, id string, item object) object {
+	stored := maps.Clone(item)
+	stored["id"] = id
+	stored["accountId"] = s.accountID
+	stored["updated"] = s.now().Format(timestampFormat)
+	stored["meta"] = object{
+		"href":         c.href(id),
+		"metadataHref": c.base + metadataPath(c.path),
+		"type":         c.itemType,
+		"mediaType":    mediaType,
+	}
+	return stored
+}
+
+// storeNested переносит позиции документа из тела запроса в отдельную коллекцию.
+func (s *store) storeNested(c *collection, item object) {
+	var rows []any
+	switch positions := item["positions"].(type) {
+	case []any:
+		rows = positions
+	case object:
+		rows, _ = positions["rows"].([]any)
+		if rows == nil {
+			return
+		}
+	default:
+		return
+	}
+
+	id := item["id"].(string)
+	positions := s.positions(c, id)
+	positions.ids, positions.items = nil, make(map[string]object)
+
+	for _, row := range rows {
+		if position, ok := row.(object); ok {
+			s.upsert(positions, position)
+		}
+	}
+
+	item["positions"] = collectionMeta(positions, len(positions.ids))
+}
+
+// positions возвращает коллекцию позиций документа c с ID id.
+func (s *store) positions(c *collection, id string) *collection {
+	return s.collection(c.path+"/"+id+"/positions", c.itemType+"position")
+}
+
+// metadataPath возвращает путь метаданных сущности, которой принадлежит коллекция path.
+func metadataPath(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) >= 2 {
+		return parts[0] + "/" + parts[1] + "/metadata"
+	}
+	return path + "/metadata"
+}
+
+// collectionMeta возвращает метаданные коллекции c из size объектов.
+func collectionMeta(c *collection, size int) object {
+	return object{
+		"meta": object{
+			"href":      c.base + c.path,
+			"type":      c.itemType,
+			"mediaType": mediaType,
+			"size":      size,
+			"limit":     1000,
+			"offset":    0,
+		},
+	}
+}
+
+// hrefID возвращает ID объекта из ссылки в его метаданных.
+func hrefID(item object) string {
+	meta, ok := item["meta"].(object)
+	if !ok {
+		return ""
+	}
+
+	href, _ := meta["href"].(string)
+	return idFromHref(href)
+}
+
+// idFromHref возвращает последний сегмент пути ссылки href без параметров.
+func idFromHref(href string) string {
+	href, _, _ = strings.Cut(href, "?")
+	return href[strings.LastIndex(href, "/")+1:]
+}
+
+// pathFromHref возвращает путь ссылки href относительно базового адреса API.
+func pathFromHref(href string) string {
+	href, _, _ = strings.Cut(href, "?")
+	if _, path, ok := strings.Cut(href, "/api/remap/1.2/"); ok {
+		return path
+	}
+	return ""
+}
+
+// newID возвращает случайный UUID.
+func newID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// clone возвращает глубокую копию значения value.
+func clone(value any) any {
+	switch v := value.(type) {
+	case object:
+		dup := make(object, len(v))
+		for key, field := range v {
+			dup[key] = clone(field)
+		}
+		return dup
+	case []any:
+		dup := make([]any, len(v))
+		for i, item := range v {
+			dup[i] = clone(item)
+		}
+		return dup
+	default:
+		return v
+	}
+}
+
+// decodeJSON декодирует тело запроса, сохраняя числа без потери точности.
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
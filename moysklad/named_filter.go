@@ -13,6 +13,8 @@ type NamedFilter struct {
 	Meta      *Meta     `json:"meta,omitempty"`      // Метаданные фильтра
 	Name      *string   `json:"name,omitempty"`      // Наименование фильтра
 	Owner     *Employee `json:"owner,omitempty"`     // Метаданные владельца (Сотрудника)
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
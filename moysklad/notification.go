@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 	"net/http"
 )
 
@@ -30,7 +31,7 @@ type NotificationInvoice struct {
 	Name                 string    `json:"name"`
 	CustomerName         string    `json:"customerName"`
 	ID                   string    `json:"id"` // ID Уведомления
-	Sum                  float64   `json:"sum"`
+	Sum                  Decimal   `json:"sum"`
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -44,7 +45,7 @@ type Order struct {
 	Name      string  `json:"name"`
 	AgentName string  `json:"agentName"` // Имя контрагента
 	ID        string  `json:"id"`        // ID Уведомления
-	Sum       float64 `json:"sum"`
+	Sum       Decimal `json:"sum"`
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -232,7 +233,7 @@ type NotificationInvoiceOutOverdue struct {
 	Description          string              `json:"description"`          // Описание уведомления
 	AccountID            string              `json:"accountId"`            // ID учетной записи
 	ID                   string              `json:"id"`                   // ID Уведомления
-	Sum                  float64             `json:"sum"`                  // Сумма счета
+	Sum                  Decimal             `json:"sum"`                  // Сумма счета
 	Read                 bool                `json:"read"`                 // Признак того, было ли Уведомление прочитано
 }
 
@@ -261,7 +262,7 @@ type NotificationOrderNew struct {
 	Title                 string    `json:"title"`                 // Краткий текст уведомления
 	AccountID             string    `json:"accountId"`             // ID учетной записи
 	ID                    string    `json:"id"`                    // ID Уведомления
-	Sum                   float64   `json:"sum"`                   // Сумма
+	Sum                   Decimal   `json:"sum"`                   // Сумма
 	Read                  bool      `json:"read"`                  // Признак того, было ли Уведомление прочитано
 }
 
@@ -290,7 +291,7 @@ type NotificationOrderOverdue struct {
 	Title                 string    `json:"title"`                 // Краткий текст уведомления
 	AccountID             string    `json:"accountId"`             // ID учетной записи
 	ID                    string    `json:"id"`                    // ID Уведомления
-	Sum                   float64   `json:"sum"`                   // Сумма
+	Sum                   Decimal   `json:"sum"`                   // Сумма
 	Read                  bool      `json:"read"`                  // Признак того, было ли Уведомление прочитано
 }
 
@@ -659,7 +660,7 @@ type NotificationRetailShift struct {
 	Open    Timestamp `json:"open"`    // Дата открытия смены
 	Name    string    `json:"name"`    // Номер смены
 	ID      string    `json:"id"`      // ID смены
-	Proceed float64   `json:"proceed"` // Выручка
+	Proceed Decimal   `json:"proceed"` // Выручка
 }
 
 // NotificationRetailShiftOpened Смена открыта.
@@ -1180,6 +1181,11 @@ type NotificationService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Notification], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех уведомлений постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Notification, error]
+
 	// GetByID выполняет запрос на получение отдельного уведомления по ID.
 	// Принимает контекст, ID уведомления и опционально объект параметров запроса Params.
 	// Возвращает найденное уведомление.
@@ -1228,12 +1234,18 @@ type notificationService struct {
 func (service *notificationService) MarkAsRead(ctx context.Context, id string) (bool, *resty.Response, error) {
 	path := fmt.Sprintf(EndpointNotificationMarkAsRead, id)
 	_, resp, err := NewRequestBuilder[any](service.client, path).Put(ctx, nil)
-	return resp.StatusCode() == http.StatusOK, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK, resp, nil
 }
 
 func (service *notificationService) MarkAsReadAll(ctx context.Context) (bool, *resty.Response, error) {
 	_, resp, err := NewRequestBuilder[any](service.client, EndpointNotificationMarkAsReadAll).Put(ctx, nil)
-	return resp.StatusCode() == http.StatusOK, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK, resp, nil
 }
 
 func (service *notificationService) GetSubscription(ctx context.Context) (*NotificationSubscription, *resty.Response, error) {
@@ -1242,7 +1254,10 @@ func (service *notificationService) GetSubscription(ctx context.Context) (*Notif
 
 func (service *notificationService) UpdateSubscription(ctx context.Context, notificationSubscription *NotificationSubscription) (bool, *resty.Response, error) {
 	_, resp, err := NewRequestBuilder[any](service.client, EndpointNotificationSubscription).Put(ctx, notificationSubscription)
-	return resp.StatusCode() == http.StatusOK, resp, err
+	if err != nil {
+		return false, resp, err
+	}
+	return resp.StatusCode() == http.StatusOK, resp, nil
 }
 
 // NewNotificationService принимает [Client] и возвращает сервис для работы с уведомлениями.
@@ -7,7 +7,7 @@ type Operation struct {
 	Group     *Group         `json:"group,omitempty"`     // Отдел сотрудника
 	Meta      *Meta          `json:"meta,omitempty"`      // Метаданные операции
 	Name      *string        `json:"name,omitempty"`      // Наименование операции
-	LinkedSum *float64       `json:"linkedSum,omitempty"` // Сумма, оплаченную по данному документу
+	LinkedSum *Decimal       `json:"linkedSum,omitempty"` // Сумма, оплаченную по данному документу
 	AccountID *string        `json:"accountId,omitempty"` // ID учётной записи
 	ID        *string        `json:"id,omitempty"`        // ID операции
 	raw       []byte         // сырые данные для последующей конвертации в нужный тип
@@ -40,7 +40,7 @@ func (operation Operation) GetPayments() Slice[Payment] {
 }
 
 // GetLinkedSum возвращает Сумму, оплаченную по данному документу.
-func (operation Operation) GetLinkedSum() float64 {
+func (operation Operation) GetLinkedSum() Decimal {
 	return Deref(operation.LinkedSum)
 }
 
@@ -61,7 +61,7 @@ func (operation *Operation) SetMeta(meta *Meta) *Operation {
 }
 
 // SetLinkedSum устанавливает Сумму, оплаченную по данному документу.
-func (operation *Operation) SetLinkedSum(linkedSum float64) *Operation {
+func (operation *Operation) SetLinkedSum(linkedSum Decimal) *Operation {
 	operation.LinkedSum = &linkedSum
 	return operation
 }
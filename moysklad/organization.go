@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -65,6 +66,8 @@ type Organization struct {
 	AdvancePaymentVat      *float64                 `json:"advancePaymentVat,omitempty"`      // Налоговая ставка для авансов для плательщиков НДС. Можно использовать значение только из существующих ставок НДС.
 	CompanyType            CompanyType              `json:"companyType,omitempty"`            // Тип Юрлица . В зависимости от значения данного поля набор выводимых реквизитов контрагента может меняться
 	Attributes             Slice[Attribute]         `json:"attributes,omitempty"`             // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -686,6 +689,11 @@ type OrganizationService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Organization], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех юрлиц постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Organization, error]
+
 	// Create выполняет запрос на создание юрлица.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование Юрлица)
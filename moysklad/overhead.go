@@ -6,12 +6,12 @@ package moysklad
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-priemka-priemki-nakladnye-rashody
 type Overhead struct {
-	Sum          *float64     `json:"sum,omitempty"`          // Сумма в копейках
+	Sum          *Decimal     `json:"sum,omitempty"`          // Сумма в копейках
 	Distribution Distribution `json:"distribution,omitempty"` // Распределение накладных расходов
 }
 
 // GetSum возвращает Сумму в копейках.
-func (overhead Overhead) GetSum() float64 {
+func (overhead Overhead) GetSum() Decimal {
 	return Deref(overhead.Sum)
 }
 
@@ -21,7 +21,7 @@ func (overhead Overhead) GetDistribution() Distribution {
 }
 
 // SetSum устанавливает Сумму в копейках.
-func (overhead *Overhead) SetSum(sum float64) *Overhead {
+func (overhead *Overhead) SetSum(sum Decimal) *Overhead {
 	overhead.Sum = &sum
 	return overhead
 }
@@ -7,9 +7,11 @@ package moysklad
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/dictionaries/#suschnosti-towar-towary-atributy-wlozhennyh-suschnostej-upakowki-towara
 type Pack struct {
 	ID       *string        `json:"id,omitempty"`       // ID упаковки товара
-	Quantity *float64       `json:"quantity,omitempty"` // Количество Товаров в упаковке данного вида
+	Quantity *Decimal       `json:"quantity,omitempty"` // Количество Товаров в упаковке данного вида
 	Uom      *Uom           `json:"uom,omitempty"`      // Единица измерения
 	Barcodes Slice[Barcode] `json:"barcodes,omitempty"` // Штрихкоды
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetID возвращает ID упаковки товара.
@@ -18,7 +20,7 @@ func (pack Pack) GetID() string {
 }
 
 // GetQuantity возвращает Количество Товаров в упаковке данного вида.
-func (pack Pack) GetQuantity() float64 {
+func (pack Pack) GetQuantity() Decimal {
 	return Deref(pack.Quantity)
 }
 
@@ -33,7 +35,7 @@ func (pack Pack) GetBarcodes() Slice[Barcode] {
 }
 
 // SetQuantity устанавливает Количество Товаров в упаковке данного вида.
-func (pack *Pack) SetQuantity(quantity float64) *Pack {
+func (pack *Pack) SetQuantity(quantity Decimal) *Pack {
 	pack.Quantity = &quantity
 	return pack
 }
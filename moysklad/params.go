@@ -10,21 +10,23 @@ import (
 
 // Params объект параметров запроса.
 type Params struct {
-	MomentFrom  string     `url:"momentFrom,omitempty"`     // Параметр выборки "От даты"
-	GroupBy     GroupBy    `url:"groupBy,omitempty"`        // Тип, по которому нужно сгруппировать выдачу
-	Fields      string     `url:"fields,omitempty"`         // Получать остатки и себестоимость позиций этих документов
-	Interval    Interval   `url:"interval,omitempty"`       // Интервал, с которым будет построен отчет
-	Search      string     `url:"search,omitempty"`         // Контекстный поиск
-	NamedFilter string     `url:"namedfilter,omitempty"`    // Применение сохраненного фильтра
-	MomentTo    string     `url:"momentTo,omitempty"`       // Параметр выборки "До даты"
-	StockType   StockType  `url:"stockType,omitempty"`      // тип остатка, резерва, ожидания, которые необходимо рассчитать
-	Order       []string   `url:"order,omitempty" del:";"`  // Сортировка списка объектов
-	Expand      []string   `url:"expand,omitempty" del:","` // Замена ссылок объектами
-	Filter      []string   `url:"filter,omitempty" del:";"` // Фильтрация
-	Action      []Evaluate `url:"action,omitempty" del:","` // Параметры автозаполнения
-	Offset      int        `url:"offset,omitempty"`         // Смещение от первого элемента (считается с нуля)
-	Limit       int        `url:"limit,omitempty"`          // Количество элементов на странице (по умолчанию 1000, максимум 1000)
-	Async       bool       `url:"async,omitempty"`          // Параметр создания асинхронной задачи
+	MomentFrom  string         `url:"momentFrom,omitempty"`     // Параметр выборки "От даты"
+	GroupBy     GroupBy        `url:"groupBy,omitempty"`        // Тип, по которому нужно сгруппировать выдачу
+	Fields      string         `url:"fields,omitempty"`         // Получать остатки и себестоимость позиций этих документов
+	Interval    Interval       `url:"interval,omitempty"`       // Интервал, с которым будет построен отчет
+	Search      string         `url:"search,omitempty"`         // Контекстный поиск
+	NamedFilter string         `url:"namedfilter,omitempty"`    // Применение сохраненного фильтра
+	MomentTo    string         `url:"momentTo,omitempty"`       // Параметр выборки "До даты"
+	StockType   StockType      `url:"stockType,omitempty"`      // тип остатка, резерва, ожидания, которые необходимо рассчитать
+	Order       []string       `url:"order,omitempty" del:";"`  // Сортировка списка объектов
+	Expand      []string       `url:"expand,omitempty" del:","` // Замена ссылок объектами
+	Filter      []string       `url:"filter,omitempty" del:";"` // Фильтрация
+	Action      []Evaluate     `url:"action,omitempty" del:","` // Параметры автозаполнения
+	Offset      int            `url:"offset,omitempty"`         // Смещение от первого элемента (считается с нуля)
+	Limit       int            `url:"limit,omitempty"`          // Количество элементов на странице (по умолчанию 1000, максимум 1000)
+	Async       bool           `url:"async,omitempty"`          // Параметр создания асинхронной задачи
+	failFast    bool           // Прекращение получения всех страниц списка при первой ошибке
+	location    *time.Location // Часовой пояс учётной записи для параметров даты и времени
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -39,7 +41,13 @@ func (params *Params) Values() url.Values {
 }
 
 func ApplyParams(params []func(*Params)) *Params {
-	p := &Params{}
+	return applyParams(params, nil)
+}
+
+// applyParams применяет параметры, переводя дату и время в часовой пояс location.
+// Если location не указан, используется [DefaultLocation].
+func applyParams(params []func(*Params), location *time.Location) *Params {
+	p := &Params{location: location}
 
 	for _, o := range params {
 		o(p)
@@ -179,12 +187,21 @@ func (groupByType GroupBy) String() string {
 	return string(groupByType)
 }
 
+// formatMoment возвращает moment в часовом поясе учётной записи в формате [TimestampFormat] (с миллисекундами).
+func (params *Params) formatMoment(moment time.Time) string {
+	location := params.location
+	if location == nil {
+		location = DefaultLocation
+	}
+	return moment.In(location).Format(TimestampFormat)
+}
+
 // WithMomentFrom Начало периода.
 //
 // momentFrom=value
 func WithMomentFrom(momentFrom time.Time) func(*Params) {
 	return func(params *Params) {
-		params.MomentFrom = momentFrom.Format(time.DateTime)
+		params.MomentFrom = params.formatMoment(momentFrom)
 	}
 }
 
@@ -193,7 +210,7 @@ func WithMomentFrom(momentFrom time.Time) func(*Params) {
 // momentTo=value
 func WithMomentTo(momentTo time.Time) func(*Params) {
 	return func(params *Params) {
-		params.MomentFrom = momentTo.Format(time.DateTime)
+		params.MomentTo = params.formatMoment(momentTo)
 	}
 }
 
@@ -355,6 +372,19 @@ func WithFilterNotEquals(key, value string) func(*Params) {
 	}
 }
 
+// WithFilterMoment Фильтрация по дате и времени.
+//
+// Время передаётся в часовом поясе учётной записи (см. Config.Location).
+//
+// Например, WithFilterMoment("updated", FilterGreaterOrEquals, since):
+//
+// key>=2006-01-02 15:04:05
+func WithFilterMoment(key string, filterType FilterType, moment time.Time) func(*Params) {
+	return func(params *Params) {
+		params.Filter = append(params.Filter, newFilter(key, params.formatMoment(moment), filterType))
+	}
+}
+
 // WithFilterEquivalence Частичное совпадение.
 //
 // key~value
@@ -483,6 +513,17 @@ func WithOffset(offset int) func(*Params) {
 	}
 }
 
+// WithFailFast прекращает получение всех объектов списка (GetListAll, GetPositionListAll) при ошибке первой же страницы.
+//
+// По умолчанию запрашиваются все страницы, а ошибки страниц возвращаются вместе в [*PagesError].
+//
+// Не передаётся в запросе.
+func WithFailFast() func(*Params) {
+	return func(params *Params) {
+		params.failFast = true
+	}
+}
+
 // WithNamedFilter позволяет использовать сохранённый фильтр в качестве параметра.
 //
 // namedfilter=https://api.moysklad.ru/api/remap/1.2/entity/product/namedfilter/b5863410-ca86-11eb-ac12-000d00000019
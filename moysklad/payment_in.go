@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -45,11 +46,13 @@ type PaymentIn struct {
 	Shared              *bool                    `json:"shared,omitempty"`              // Общий доступ
 	SalesChannel        *NullValue[SalesChannel] `json:"salesChannel,omitempty"`        // Метаданные канала продаж
 	State               *NullValue[State]        `json:"state,omitempty"`               // Метаданные статуса Входящего платежа
-	Sum                 *float64                 `json:"sum,omitempty"`                 // Сумма Входящего платежа в установленной валюте
+	Sum                 *Decimal                 `json:"sum,omitempty"`                 // Сумма Входящего платежа в установленной валюте
 	SyncID              *string                  `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp               `json:"updated,omitempty"`             // Момент последнего обновления Входящего платежа
 	AccountID           *string                  `json:"accountId,omitempty"`           // ID учётной записи
 	Attributes          Slice[Attribute]         `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -231,7 +234,7 @@ func (paymentIn PaymentIn) GetState() State {
 }
 
 // GetSum возвращает Сумму Входящего платежа в установленной валюте.
-func (paymentIn PaymentIn) GetSum() float64 {
+func (paymentIn PaymentIn) GetSum() Decimal {
 	return Deref(paymentIn.Sum)
 }
 
@@ -448,7 +451,7 @@ func (paymentIn *PaymentIn) SetState(state *State) *PaymentIn {
 }
 
 // SetSum устанавливает Сумму Входящего платежа в установленной валюте.
-func (paymentIn *PaymentIn) SetSum(sum float64) *PaymentIn {
+func (paymentIn *PaymentIn) SetSum(sum Decimal) *PaymentIn {
 	paymentIn.Sum = &sum
 	return paymentIn
 }
@@ -509,6 +512,11 @@ type PaymentInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PaymentIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех входящих платежей постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PaymentIn, error]
+
 	// Create выполняет запрос на создание входящего платежа.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -45,12 +46,14 @@ type PaymentOut struct {
 	SalesChannel        *NullValue[SalesChannel] `json:"salesChannel,omitempty"`        // Метаданные канала продаж
 	Shared              *bool                    `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]        `json:"state,omitempty"`               // Метаданные статуса Исходящего платежа
-	Sum                 *float64                 `json:"sum,omitempty"`                 // Сумма Исходящего платежа в установленной валюте
+	Sum                 *Decimal                 `json:"sum,omitempty"`                 // Сумма Исходящего платежа в установленной валюте
 	SyncID              *string                  `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp               `json:"updated,omitempty"`             // Момент последнего обновления Исходящего платежа
-	VatSum              *float64                 `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Decimal                 `json:"vatSum,omitempty"`              // Сумма НДС
 	AccountID           *string                  `json:"accountId,omitempty"`           // ID учётной записи
 	Attributes          Slice[Attribute]         `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -236,7 +239,7 @@ func (paymentOut PaymentOut) GetState() State {
 }
 
 // GetSum возвращает Сумму Исходящего платежа в копейках.
-func (paymentOut PaymentOut) GetSum() float64 {
+func (paymentOut PaymentOut) GetSum() Decimal {
 	return Deref(paymentOut.Sum)
 }
 
@@ -251,7 +254,7 @@ func (paymentOut PaymentOut) GetUpdated() time.Time {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (paymentOut PaymentOut) GetVatSum() float64 {
+func (paymentOut PaymentOut) GetVatSum() Decimal {
 	return Deref(paymentOut.VatSum)
 }
 
@@ -459,7 +462,7 @@ func (paymentOut *PaymentOut) SetState(state *State) *PaymentOut {
 }
 
 // SetSum устанавливает Сумму Исходящего платежа в установленной валюте.
-func (paymentOut *PaymentOut) SetSum(sum float64) *PaymentOut {
+func (paymentOut *PaymentOut) SetSum(sum Decimal) *PaymentOut {
 	paymentOut.Sum = &sum
 	return paymentOut
 }
@@ -515,6 +518,11 @@ type PaymentOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PaymentOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех исходящих платежей постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PaymentOut, error]
+
 	// Create выполняет запрос на создание исходящего платежа.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
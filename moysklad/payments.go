@@ -34,11 +34,11 @@ type Payment struct {
 	SalesChannel   *NullValue[SalesChannel] `json:"salesChannel,omitempty"`   // Метаданные канала продаж
 	Shared         *bool                    `json:"shared,omitempty"`         // Общий доступ
 	State          *State                   `json:"state,omitempty"`          // Метаданные статуса платежа
-	Sum            *float64                 `json:"sum,omitempty"`            // Сумма платежа в копейках
+	Sum            *Decimal                 `json:"sum,omitempty"`            // Сумма платежа в копейках
 	SyncID         *string                  `json:"syncId,omitempty"`         // ID синхронизации
 	Updated        *Timestamp               `json:"updated,omitempty"`        // Момент последнего обновления платежа
-	VatSum         *float64                 `json:"vatSum,omitempty"`         // Сумма НДС
-	LinkedSum      *float64                 `json:"linkedSum,omitempty"`      // Сумма, оплаченная по документу из этого платежа
+	VatSum         *Decimal                 `json:"vatSum,omitempty"`         // Сумма НДС
+	LinkedSum      *Decimal                 `json:"linkedSum,omitempty"`      // Сумма, оплаченная по документу из этого платежа
 	Operations     Operations               `json:"operations,omitempty"`     // Массив ссылок на связанные операции в формате Метаданных
 	raw            []byte                   // сырые данные для последующей конвертации в нужный тип
 }
@@ -179,7 +179,7 @@ func (payment Payment) GetState() State {
 }
 
 // GetSum возвращает Сумму платежа в копейках.
-func (payment Payment) GetSum() float64 {
+func (payment Payment) GetSum() Decimal {
 	return Deref(payment.Sum)
 }
 
@@ -194,12 +194,12 @@ func (payment Payment) GetUpdated() time.Time {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (payment Payment) GetVatSum() float64 {
+func (payment Payment) GetVatSum() Decimal {
 	return Deref(payment.VatSum)
 }
 
 // GetLinkedSum возвращает Сумму, оплаченную по документу из этого платежа.
-func (payment Payment) GetLinkedSum() float64 {
+func (payment Payment) GetLinkedSum() Decimal {
 	return Deref(payment.LinkedSum)
 }
 
@@ -19,13 +19,15 @@ type Payroll struct {
 	ExternalCode *string          `json:"externalCode,omitempty"` // Внешний код Начисления зарплаты
 	Moment       *Timestamp       `json:"moment,omitempty"`       // Дата документа
 	Applicable   *bool            `json:"applicable,omitempty"`   // Отметка о проведении
-	Sum          *float64         `json:"sum,omitempty"`          // Сумма в копейках
+	Sum          *Decimal         `json:"sum,omitempty"`          // Сумма в копейках
 	Organization *Organization    `json:"organization,omitempty"` // Метаданные юрлица
 	Created      *Timestamp       `json:"created,omitempty"`      // Момент создания
 	Printed      *bool            `json:"printed,omitempty"`      // Напечатан ли документ
 	Published    *bool            `json:"published,omitempty"`    // Опубликован ли документ
 	Files        *MetaArray[File] `json:"files,omitempty"`        // Метаданные массива Файлов (Максимальное количество файлов - 100)
 	State        *State           `json:"state,omitempty"`        // Метаданные статуса Начисления зарплаты
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // AsTaskOperation реализует интерфейс [TaskOperationConverter].
@@ -89,7 +91,7 @@ func (payroll Payroll) GetApplicable() bool {
 }
 
 // GetSum возвращает Сумму в копейках.
-func (payroll Payroll) GetSum() float64 {
+func (payroll Payroll) GetSum() Decimal {
 	return Deref(payroll.Sum)
 }
 
@@ -0,0 +1,275 @@
+package moysklad
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// PoolConfig конфигурация пула клиентов [Pool].
+type PoolConfig struct {
+	// Базовая конфигурация клиентов учётных записей.
+	//
+	// Поля RestyClient, Token, Username, Password, TokenProvider, Limiter и CircuitBreaker не используются:
+	// каждая учётная запись получает собственные источник токена, ограничитель запросов и автоматический выключатель.
+	// Транспорт поля HTTPClient используется всеми клиентами пула.
+	Config Config
+
+	// Функция, возвращающая источник токена учётной записи accountID (обязательно).
+	TokenProvider func(accountID string) TokenProvider
+
+	// Функция, возвращающая ограничитель запросов учётной записи accountID.
+	//
+	// Если не указана, каждая учётная запись получает собственный [DefaultLimiter].
+	Limiter func(accountID string) Limiter
+
+	// Функция, возвращающая автоматический выключатель учётной записи accountID.
+	//
+	// Функция должна возвращать новый экземпляр, чтобы ошибки одной учётной записи не размыкали выключатель другой.
+	// Если не указана, выключатель не используется. См. [CircuitBreaker].
+	CircuitBreaker func(accountID string) *CircuitBreaker
+
+	// Функция, возвращающая часовой пояс учётной записи accountID.
+	//
+	// Если не указана, используется поле Location базовой конфигурации.
+	Location func(accountID string) *time.Location
+
+	// Время, по истечении которого неиспользуемый клиент учётной записи удаляется из пула.
+	//
+	// Если не указано, клиенты не удаляются.
+	IdleTimeout time.Duration
+}
+
+// TenantStats статистика запросов учётной записи в пуле [Pool].
+type TenantStats struct {
+	AccountID   string    // ID учётной записи
+	Requests    int64     // Количество выполненных запросов
+	Errors      int64     // Количество запросов, завершившихся ошибкой
+	RateLimited int64     // Количество ответов 429
+	InFlight    int64     // Количество выполняемых запросов
+	Created     time.Time // Момент создания клиента
+	LastUsed    time.Time // Момент последнего обращения к клиенту
+}
+
+// Pool пул клиентов для работы с несколькими учётными записями МойСклад.
+//
+// Клиенты создаются при первом обращении и используют общий HTTP-транспорт,
+// при этом ограничения на количество запросов соблюдаются для каждой учётной записи отдельно.
+//
+// # Пример:
+//
+//	pool := moysklad.NewPool(moysklad.PoolConfig{
+//		TokenProvider: func(accountID string) moysklad.TokenProvider {
+//			return moysklad.TokenProviderFunc(func(ctx context.Context) (string, error) {
+//				return tokens.Get(ctx, accountID)
+//			})
+//		},
+//		IdleTimeout: time.Hour,
+//	})
+//
+//	order, _, err := pool.For(accountID).Entity().CustomerOrder().GetByID(ctx, orderID)
+type Pool struct {
+	config    PoolConfig
+	transport http.RoundTripper
+	tenants   map[string]*tenant
+	lastSweep time.Time
+	mu        sync.Mutex
+}
+
+type tenant struct {
+	client      *Client
+	created     time.Time
+	lastUsed    atomic.Int64 // unix nano
+	requests    atomic.Int64
+	errors      atomic.Int64
+	rateLimited atomic.Int64
+	inFlight    atomic.Int64
+}
+
+// NewPool возвращает новый пул клиентов.
+func NewPool(config PoolConfig) *Pool {
+	transport := http.DefaultTransport
+	if config.Config.HTTPClient != nil && config.Config.HTTPClient.Transport != nil {
+		transport = config.Config.HTTPClient.Transport
+	} else if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	}
+
+	return &Pool{
+		config:    config,
+		transport: transport,
+		tenants:   make(map[string]*tenant),
+	}
+}
+
+// For возвращает клиент учётной записи accountID, создавая его при первом обращении.
+func (pool *Pool) For(accountID string) *Client {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	now := time.Now()
+	pool.sweep(now)
+
+	t, ok := pool.tenants[accountID]
+	if !ok {
+		t = pool.newTenant(accountID, now)
+		pool.tenants[accountID] = t
+	}
+
+	t.lastUsed.Store(now.UnixNano())
+	return t.client
+}
+
+// Evict удаляет клиент учётной записи accountID из пула.
+// Выполняемые запросы клиента не прерываются.
+func (pool *Pool) Evict(accountID string) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	delete(pool.tenants, accountID)
+}
+
+// Len возвращает количество клиентов в пуле.
+func (pool *Pool) Len() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	return len(pool.tenants)
+}
+
+// Stats возвращает статистику запросов учётной записи accountID.
+// Возвращает «false», если клиент учётной записи отсутствует в пуле.
+func (pool *Pool) Stats(accountID string) (TenantStats, bool) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	t, ok := pool.tenants[accountID]
+	if !ok {
+		return TenantStats{}, false
+	}
+	return t.stats(accountID), true
+}
+
+// AllStats возвращает статистику запросов всех учётных записей пула, упорядоченную по ID учётной записи.
+func (pool *Pool) AllStats() []TenantStats {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	stats := make([]TenantStats, 0, len(pool.tenants))
+	for accountID, t := range pool.tenants {
+		stats = append(stats, t.stats(accountID))
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].AccountID < stats[j].AccountID
+	})
+
+	return stats
+}
+
+// sweep удаляет клиенты, не использовавшиеся дольше IdleTimeout.
+// Выполняется не чаще, чем раз в половину IdleTimeout.
+func (pool *Pool) sweep(now time.Time) {
+	idleTimeout := pool.config.IdleTimeout
+	if idleTimeout <= 0 || now.Sub(pool.lastSweep) < idleTimeout/2 {
+		return
+	}
+	pool.lastSweep = now
+
+	for accountID, t := range pool.tenants {
+		if t.inFlight.Load() == 0 && now.Sub(time.Unix(0, t.lastUsed.Load())) > idleTimeout {
+			delete(pool.tenants, accountID)
+		}
+	}
+}
+
+// newTenant создаёт клиент учётной записи accountID.
+func (pool *Pool) newTenant(accountID string, now time.Time) *tenant {
+	t := &tenant{created: now}
+
+	config := pool.config.Config
+
+	httpClient := &http.Client{Transport: pool.transport}
+	if config.HTTPClient != nil {
+		httpClient.Timeout = config.HTTPClient.Timeout
+	}
+
+	config.RestyClient = resty.NewWithClient(httpClient)
+	config.HTTPClient = nil
+	config.Token, config.Username, config.Password = "", "", ""
+
+	config.TokenProvider = nil
+	if pool.config.TokenProvider != nil {
+		config.TokenProvider = pool.config.TokenProvider(accountID)
+	}
+
+	config.Limiter = nil
+	if pool.config.Limiter != nil {
+		config.Limiter = pool.config.Limiter(accountID)
+	}
+
+	config.CircuitBreaker = nil
+	if pool.config.CircuitBreaker != nil {
+		config.CircuitBreaker = pool.config.CircuitBreaker(accountID)
+	}
+
+	// записи кэша учётных записей не должны пересекаться во внешнем хранилище
+	if config.Cache != nil {
+		cache := *config.Cache
+		cache.Namespace += accountID + "/"
+		config.Cache = &cache
+	}
+
+	if pool.config.Location != nil {
+		config.Location = pool.config.Location(accountID)
+	}
+
+	if config.Logger != nil {
+		config.Logger = config.Logger.With("account", accountID)
+	}
+
+	// статистика учитывает запросы целиком, вместе с повторными попытками
+	config.Middlewares = append([]Middleware{t.middleware}, config.Middlewares...)
+
+	t.client = New(config)
+	return t
+}
+
+// middleware собирает статистику запросов учётной записи.
+func (t *tenant) middleware(next Handler) Handler {
+	return func(ctx context.Context, info *RequestInfo) (*resty.Response, error) {
+		t.inFlight.Add(1)
+		defer t.inFlight.Add(-1)
+
+		t.lastUsed.Store(time.Now().UnixNano())
+		t.requests.Add(1)
+
+		resp, err := next(ctx, info)
+
+		if err != nil || (resp != nil && resp.IsError()) {
+			t.errors.Add(1)
+		}
+
+		if resp != nil && resp.StatusCode() == http.StatusTooManyRequests {
+			t.rateLimited.Add(1)
+		}
+
+		return resp, err
+	}
+}
+
+func (t *tenant) stats(accountID string) TenantStats {
+	return TenantStats{
+		AccountID:   accountID,
+		Requests:    t.requests.Load(),
+		Errors:      t.errors.Load(),
+		RateLimited: t.rateLimited.Load(),
+		InFlight:    t.inFlight.Load(),
+		Created:     t.created,
+		LastUsed:    time.Unix(0, t.lastUsed.Load()),
+	}
+}
@@ -0,0 +1,56 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+)
+
+func TestPoolCircuitBreakerPerTenant(t *testing.T) {
+	// учётная запись broken получает ответы 5xx, остальные – пустой список
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer broken" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"size":0},"rows":[]}`))
+	}))
+	defer server.Close()
+
+	breakers := make(map[string]*moysklad.CircuitBreaker)
+	pool := moysklad.NewPool(moysklad.PoolConfig{
+		Config: moysklad.Config{BaseURL: server.URL + "/"},
+		TokenProvider: func(accountID string) moysklad.TokenProvider {
+			return moysklad.StaticToken(accountID)
+		},
+		CircuitBreaker: func(accountID string) *moysklad.CircuitBreaker {
+			breakers[accountID] = moysklad.NewCircuitBreaker(moysklad.CircuitBreakerConfig{FailureThreshold: 1})
+			return breakers[accountID]
+		},
+	})
+
+	ctx := context.Background()
+
+	if _, _, err := pool.For("broken").Entity().Product().GetList(ctx); err == nil {
+		t.Fatal("broken account returned no error")
+	}
+	if _, _, err := pool.For("broken").Entity().Product().GetList(ctx); !errors.Is(err, moysklad.ErrCircuitOpen) {
+		t.Errorf("broken account error = %v, want ErrCircuitOpen", err)
+	}
+
+	if _, _, err := pool.For("healthy").Entity().Product().GetList(ctx); err != nil {
+		t.Errorf("healthy account error = %v", err)
+	}
+
+	if breakers["broken"] == breakers["healthy"] {
+		t.Fatal("accounts share a circuit breaker")
+	}
+	if state := breakers["healthy"].State(); state != moysklad.CircuitClosed {
+		t.Errorf("healthy account breaker state = %s, want closed", state)
+	}
+}
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type Prepayment struct {
 	Owner         *Employee                      `json:"owner,omitempty"`         // Метаданные владельца (Сотрудника)
 	Applicable    *bool                          `json:"applicable,omitempty"`    // Отметка о проведении
 	Agent         *Agent                         `json:"agent,omitempty"`         // Метаданные контрагента
-	CashSum       *float64                       `json:"cashSum,omitempty"`       // Оплачено наличными
+	CashSum       *Decimal                       `json:"cashSum,omitempty"`       // Оплачено наличными
 	Code          *string                        `json:"code,omitempty"`          // Код Предоплаты
 	Created       *Timestamp                     `json:"created,omitempty"`       // Дата создания
 	CustomerOrder *CustomerOrder                 `json:"customerOrder,omitempty"` // Метаданные Заказа Покупателя
@@ -32,26 +33,28 @@ type Prepayment struct {
 	Meta          *Meta                          `json:"meta,omitempty"`          // Метаданные Предоплаты
 	Moment        *Timestamp                     `json:"moment,omitempty"`        // Дата документа
 	Name          *string                        `json:"name,omitempty"`          // Наименование Предоплаты
-	NoCashSum     *float64                       `json:"noCashSum,omitempty"`     // Оплачено картой
+	NoCashSum     *Decimal                       `json:"noCashSum,omitempty"`     // Оплачено картой
 	AccountID     *string                        `json:"accountId,omitempty"`     // ID учётной записи
 	VatIncluded   *bool                          `json:"vatIncluded,omitempty"`   // Включен ли НДС в цену
 	Positions     *MetaArray[PrepaymentPosition] `json:"positions,omitempty"`     // Метаданные позиций Предоплаты
 	Printed       *bool                          `json:"printed,omitempty"`       // Напечатан ли документ
 	Published     *bool                          `json:"published,omitempty"`     // Опубликован ли документ
-	QRSum         *float64                       `json:"qrSum,omitempty"`         // Оплачено по QR-коду
+	QRSum         *Decimal                       `json:"qrSum,omitempty"`         // Оплачено по QR-коду
 	Rate          *NullValue[Rate]               `json:"rate,omitempty"`          // Валюта
 	RetailShift   *RetailShift                   `json:"retailShift,omitempty"`   // Метаданные Розничной смены
 	RetailStore   *RetailStore                   `json:"retailStore,omitempty"`   // Метаданные Точки продаж
 	Organization  *Organization                  `json:"organization,omitempty"`  // Метаданные юрлица
 	Shared        *bool                          `json:"shared,omitempty"`        // Общий доступ
 	State         *State                         `json:"state,omitempty"`         // Метаданные статуса Предоплаты
-	Sum           *float64                       `json:"sum,omitempty"`           // Сумма Предоплаты в копейках
+	Sum           *Decimal                       `json:"sum,omitempty"`           // Сумма Предоплаты в копейках
 	SyncID        *string                        `json:"syncId,omitempty"`        // ID синхронизации
-	VatSum        *float64                       `json:"vatSum,omitempty"`        // Сумма НДС
+	VatSum        *Decimal                       `json:"vatSum,omitempty"`        // Сумма НДС
 	Updated       *Timestamp                     `json:"updated,omitempty"`       // Момент последнего обновления Предоплаты
 	VatEnabled    *bool                          `json:"vatEnabled,omitempty"`    // Учитывается ли НДС
 	TaxSystem     TaxSystem                      `json:"taxSystem,omitempty"`     // Код системы налогообложения
 	Attributes    Slice[Attribute]               `json:"attributes,omitempty"`    // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -90,7 +93,7 @@ func (prepayment Prepayment) GetAgent() Agent {
 }
 
 // GetCashSum возвращает Оплачено наличными.
-func (prepayment Prepayment) GetCashSum() float64 {
+func (prepayment Prepayment) GetCashSum() Decimal {
 	return Deref(prepayment.CashSum)
 }
 
@@ -155,7 +158,7 @@ func (prepayment Prepayment) GetName() string {
 }
 
 // GetNoCashSum возвращает Оплачено картой.
-func (prepayment Prepayment) GetNoCashSum() float64 {
+func (prepayment Prepayment) GetNoCashSum() Decimal {
 	return Deref(prepayment.NoCashSum)
 }
 
@@ -185,7 +188,7 @@ func (prepayment Prepayment) GetPublished() bool {
 }
 
 // GetQRSum возвращает оплачено по QR-коду.
-func (prepayment Prepayment) GetQRSum() float64 {
+func (prepayment Prepayment) GetQRSum() Decimal {
 	return Deref(prepayment.QRSum)
 }
 
@@ -220,7 +223,7 @@ func (prepayment Prepayment) GetState() State {
 }
 
 // GetSum возвращает Сумму Перемещения в копейках.
-func (prepayment Prepayment) GetSum() float64 {
+func (prepayment Prepayment) GetSum() Decimal {
 	return Deref(prepayment.Sum)
 }
 
@@ -230,7 +233,7 @@ func (prepayment Prepayment) GetSyncID() string {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (prepayment Prepayment) GetVatSum() float64 {
+func (prepayment Prepayment) GetVatSum() Decimal {
 	return Deref(prepayment.VatSum)
 }
 
@@ -277,10 +280,12 @@ type PrepaymentPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -311,7 +316,7 @@ func (prepaymentPosition PrepaymentPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (prepaymentPosition PrepaymentPosition) GetPrice() float64 {
+func (prepaymentPosition PrepaymentPosition) GetPrice() Decimal {
 	return Deref(prepaymentPosition.Price)
 }
 
@@ -319,7 +324,7 @@ func (prepaymentPosition PrepaymentPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (prepaymentPosition PrepaymentPosition) GetQuantity() float64 {
+func (prepaymentPosition PrepaymentPosition) GetQuantity() Decimal {
 	return Deref(prepaymentPosition.Quantity)
 }
 
@@ -361,6 +366,11 @@ type PrepaymentService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Prepayment], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех предоплат постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Prepayment, error]
+
 	// DeleteByID выполняет запрос на удаление предоплаты по ID.
 	// Принимает контекст и ID предоплаты.
 	// Возвращает «true» в случае успешного удаления предоплаты.
@@ -388,6 +398,11 @@ type PrepaymentService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PrepaymentPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PrepaymentPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type PrepaymentReturn struct {
 	Organization *Organization                        `json:"organization,omitempty"` // Метаданные юрлица
 	Applicable   *bool                                `json:"applicable,omitempty"`   // Отметка о проведении
 	AccountID    *string                              `json:"accountId,omitempty"`    // ID учётной записи
-	CashSum      *float64                             `json:"cashSum,omitempty"`      // Оплачено наличными
+	CashSum      *Decimal                             `json:"cashSum,omitempty"`      // Оплачено наличными
 	Code         *string                              `json:"code,omitempty"`         // Код Возврата предоплаты
 	Created      *Timestamp                           `json:"created,omitempty"`      // Дата создания
 	Deleted      *Timestamp                           `json:"deleted,omitempty"`      // Момент последнего удаления Возврата предоплаты
@@ -31,26 +32,28 @@ type PrepaymentReturn struct {
 	Meta         *Meta                                `json:"meta,omitempty"`         // Метаданные Возврата предоплаты
 	Moment       *Timestamp                           `json:"moment,omitempty"`       // Дата документа
 	Name         *string                              `json:"name,omitempty"`         // Наименование Возврата предоплаты
-	NoCashSum    *float64                             `json:"noCashSum,omitempty"`    // Оплачено картой
+	NoCashSum    *Decimal                             `json:"noCashSum,omitempty"`    // Оплачено картой
 	Owner        *Employee                            `json:"owner,omitempty"`        // Метаданные владельца (Сотрудника)
 	VatIncluded  *bool                                `json:"vatIncluded,omitempty"`  // Включен ли НДС в цену
 	Positions    *MetaArray[PrepaymentReturnPosition] `json:"positions,omitempty"`    // Метаданные позиций Возврата предоплаты
 	Prepayment   *Prepayment                          `json:"prepayment,omitempty"`   // Метаданные Предоплаты
 	Printed      *bool                                `json:"printed,omitempty"`      // Напечатан ли документ
 	Published    *bool                                `json:"published,omitempty"`    // Опубликован ли документ
-	QRSum        *float64                             `json:"qrSum,omitempty"`        // Оплачено по QR-коду
+	QRSum        *Decimal                             `json:"qrSum,omitempty"`        // Оплачено по QR-коду
 	Rate         *NullValue[Rate]                     `json:"rate,omitempty"`         // Валюта
 	RetailShift  *RetailShift                         `json:"retailShift,omitempty"`  // Метаданные Розничной смены
 	RetailStore  *RetailStore                         `json:"retailStore,omitempty"`  // Метаданные Точки продаж
 	Shared       *bool                                `json:"shared,omitempty"`       // Общий доступ
 	State        *State                               `json:"state,omitempty"`        // Метаданные статуса Возврата предоплаты
-	Sum          *float64                             `json:"sum,omitempty"`          // Сумма Возврата предоплаты в копейках
+	Sum          *Decimal                             `json:"sum,omitempty"`          // Сумма Возврата предоплаты в копейках
 	SyncID       *string                              `json:"syncId,omitempty"`       // ID синхронизации
-	VatSum       *float64                             `json:"vatSum,omitempty"`       // Сумма НДС
+	VatSum       *Decimal                             `json:"vatSum,omitempty"`       // Сумма НДС
 	Updated      *Timestamp                           `json:"updated,omitempty"`      // Момент последнего обновления Возврата предоплаты
 	VatEnabled   *bool                                `json:"vatEnabled,omitempty"`   // Учитывается ли НДС
 	TaxSystem    TaxSystem                            `json:"taxSystem,omitempty"`    // Код системы налогообложения
 	Attributes   Slice[Attribute]                     `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -89,7 +92,7 @@ func (prepaymentReturn PrepaymentReturn) GetAccountID() string {
 }
 
 // GetCashSum возвращает Оплачено наличными.
-func (prepaymentReturn PrepaymentReturn) GetCashSum() float64 {
+func (prepaymentReturn PrepaymentReturn) GetCashSum() Decimal {
 	return Deref(prepaymentReturn.CashSum)
 }
 
@@ -149,7 +152,7 @@ func (prepaymentReturn PrepaymentReturn) GetName() string {
 }
 
 // GetNoCashSum возвращает Оплачено картой.
-func (prepaymentReturn PrepaymentReturn) GetNoCashSum() float64 {
+func (prepaymentReturn PrepaymentReturn) GetNoCashSum() Decimal {
 	return Deref(prepaymentReturn.NoCashSum)
 }
 
@@ -184,7 +187,7 @@ func (prepaymentReturn PrepaymentReturn) GetPublished() bool {
 }
 
 // GetQRSum возвращает оплачено по QR-коду.
-func (prepaymentReturn PrepaymentReturn) GetQRSum() float64 {
+func (prepaymentReturn PrepaymentReturn) GetQRSum() Decimal {
 	return Deref(prepaymentReturn.QRSum)
 }
 
@@ -214,7 +217,7 @@ func (prepaymentReturn PrepaymentReturn) GetState() State {
 }
 
 // GetSum возвращает Сумму Возврата предоплаты в копейках.
-func (prepaymentReturn PrepaymentReturn) GetSum() float64 {
+func (prepaymentReturn PrepaymentReturn) GetSum() Decimal {
 	return Deref(prepaymentReturn.Sum)
 }
 
@@ -224,7 +227,7 @@ func (prepaymentReturn PrepaymentReturn) GetSyncID() string {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (prepaymentReturn PrepaymentReturn) GetVatSum() float64 {
+func (prepaymentReturn PrepaymentReturn) GetVatSum() Decimal {
 	return Deref(prepaymentReturn.VatSum)
 }
 
@@ -271,10 +274,12 @@ type PrepaymentReturnPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -305,12 +310,12 @@ func (prepaymentReturnPosition PrepaymentReturnPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (prepaymentReturnPosition PrepaymentReturnPosition) GetPrice() float64 {
+func (prepaymentReturnPosition PrepaymentReturnPosition) GetPrice() Decimal {
 	return Deref(prepaymentReturnPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (prepaymentReturnPosition PrepaymentReturnPosition) GetQuantity() float64 {
+func (prepaymentReturnPosition PrepaymentReturnPosition) GetQuantity() Decimal {
 	return Deref(prepaymentReturnPosition.Quantity)
 }
 
@@ -350,6 +355,11 @@ type PrepaymentReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PrepaymentReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех возвратов предоплат постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PrepaymentReturn, error]
+
 	// GetByID выполняет запрос на получение отдельного возврата предоплаты по ID.
 	// Принимает контекст, ID возврата предоплаты и опционально объект параметров запроса Params.
 	// Возвращает найденный возврат предоплаты.
@@ -403,6 +413,11 @@ type PrepaymentReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PrepaymentReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PrepaymentReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -40,6 +41,8 @@ type PriceList struct {
 	State        *NullValue[State]             `json:"state,omitempty"`        // Метаданные статуса Прайс-листа
 	SyncID       *string                       `json:"syncId,omitempty"`       // ID синхронизации
 	Attributes   Slice[Attribute]              `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -332,7 +335,7 @@ func (priceList *PriceList) Delete(ctx context.Context, client *Client) (bool, *
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-prajs-list-prajs-listy-yachejki
 type PriceListCell struct {
 	Column *string  `json:"column,omitempty"` // Название столбца, к которому относится данная ячейка
-	Sum    *float64 `json:"sum,omitempty"`    // Числовое значение ячейки
+	Sum    *Decimal `json:"sum,omitempty"`    // Числовое значение ячейки
 }
 
 // GetColumn возвращает Название столбца, к которому относится данная ячейка.
@@ -341,7 +344,7 @@ func (priceListCell PriceListCell) GetColumn() string {
 }
 
 // GetSum возвращает Числовое значение ячейки.
-func (priceListCell PriceListCell) GetSum() float64 {
+func (priceListCell PriceListCell) GetSum() Decimal {
 	return Deref(priceListCell.Sum)
 }
 
@@ -352,7 +355,7 @@ func (priceListCell *PriceListCell) SetColumn(column string) *PriceListCell {
 }
 
 // SetSum устанавливает Числовое значение ячейки.
-func (priceListCell *PriceListCell) SetSum(sum float64) *PriceListCell {
+func (priceListCell *PriceListCell) SetSum(sum Decimal) *PriceListCell {
 	priceListCell.Sum = &sum
 	return priceListCell
 }
@@ -412,6 +415,8 @@ type PriceListPosition struct {
 	ID         *string              `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack                `json:"pack,omitempty"`       // Упаковка Товара
 	Cells      Slice[PriceListCell] `json:"cells,omitempty"`      // Массив значений столбцов в позиции Прайс-листа
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -487,6 +492,11 @@ type PriceListService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PriceList], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех прайс-листов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PriceList, error]
+
 	// Create выполняет запрос на создание прайс-листа.
 	// Обязательные поля для заполнения:
 	//	- columns (Массив объектов, описывающих столбцы нового прайс-листа)
@@ -537,6 +547,11 @@ type PriceListService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PriceListPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PriceListPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -12,6 +12,8 @@ type PriceType struct {
 	ID           *string `json:"id,omitempty"`           // ID типа цены
 	Meta         *Meta   `json:"meta,omitempty"`         // Метаданные Типа цены
 	Name         *string `json:"name,omitempty"`         // Наименование Типа цены
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
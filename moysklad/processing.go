@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -36,18 +37,20 @@ type Processing struct {
 	Owner               *Employee                         `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
 	Printed             *bool                             `json:"printed,omitempty"`             // Напечатан ли документ
 	ProcessingPlan      *ProcessingPlan                   `json:"processingPlan,omitempty"`      // Метаданные Техкарты
-	ProcessingSum       *float64                          `json:"processingSum,omitempty"`       // Затраты на производство за единицу объема производства
+	ProcessingSum       *Decimal                          `json:"processingSum,omitempty"`       // Затраты на производство за единицу объема производства
 	Updated             *Timestamp                        `json:"updated,omitempty"`             // Момент последнего обновления Техоперации
 	ProductsStore       *Store                            `json:"productsStore,omitempty"`       // Метаданные склада для продукции
 	Project             *NullValue[Project]               `json:"project,omitempty"`             // Метаданные проекта
 	Published           *bool                             `json:"published,omitempty"`           // Опубликован ли документ
-	Quantity            *float64                          `json:"quantity,omitempty"`            // Объем производства
+	Quantity            *Decimal                          `json:"quantity,omitempty"`            // Объем производства
 	Shared              *bool                             `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]                 `json:"state,omitempty"`               // Метаданные статуса Техоперации
 	Name                *string                           `json:"name,omitempty"`                // Наименование Техоперации
 	Products            Slice[ProcessingPositionProduct]  `json:"products,omitempty"`            // Список Метаданных готовых продуктов Техоперации
 	Materials           Slice[ProcessingPositionMaterial] `json:"materials,omitempty"`           // Список Метаданных материалов Техоперации
 	Attributes          Slice[Attribute]                  `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -166,7 +169,7 @@ func (processing Processing) GetProcessingPlan() ProcessingPlan {
 }
 
 // GetProcessingSum возвращает Затраты на производство за единицу объема производства.
-func (processing Processing) GetProcessingSum() float64 {
+func (processing Processing) GetProcessingSum() Decimal {
 	return Deref(processing.ProcessingSum)
 }
 
@@ -191,7 +194,7 @@ func (processing Processing) GetPublished() bool {
 }
 
 // GetQuantity возвращает Объем производства.
-func (processing Processing) GetQuantity() float64 {
+func (processing Processing) GetQuantity() Decimal {
 	return Deref(processing.Quantity)
 }
 
@@ -330,7 +333,7 @@ func (processing *Processing) SetProcessingPlan(processingPlan *ProcessingPlan)
 }
 
 // SetProcessingSum устанавливает Затраты на производство за единицу объема производства.
-func (processing *Processing) SetProcessingSum(processingSum float64) *Processing {
+func (processing *Processing) SetProcessingSum(processingSum Decimal) *Processing {
 	processing.ProcessingSum = &processingSum
 	return processing
 }
@@ -352,7 +355,7 @@ func (processing *Processing) SetProject(project *Project) *Processing {
 }
 
 // SetQuantity устанавливает Объем производства.
-func (processing *Processing) SetQuantity(quantity float64) *Processing {
+func (processing *Processing) SetQuantity(quantity Decimal) *Processing {
 	processing.Quantity = &quantity
 	return processing
 }
@@ -437,7 +440,9 @@ type ProcessingPositionMaterial struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -456,7 +461,7 @@ func (processingPositionMaterial ProcessingPositionMaterial) GetID() string {
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции
-func (processingPositionMaterial ProcessingPositionMaterial) GetQuantity() float64 {
+func (processingPositionMaterial ProcessingPositionMaterial) GetQuantity() Decimal {
 	return Deref(processingPositionMaterial.Quantity)
 }
 
@@ -471,7 +476,7 @@ func (processingPositionMaterial *ProcessingPositionMaterial) SetAssortment(asso
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (processingPositionMaterial *ProcessingPositionMaterial) SetQuantity(quantity float64) *ProcessingPositionMaterial {
+func (processingPositionMaterial *ProcessingPositionMaterial) SetQuantity(quantity Decimal) *ProcessingPositionMaterial {
 	processingPositionMaterial.Quantity = &quantity
 	return processingPositionMaterial
 }
@@ -497,7 +502,9 @@ type ProcessingPositionProduct struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -516,7 +523,7 @@ func (processingPositionProduct ProcessingPositionProduct) GetID() string {
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (processingPositionProduct ProcessingPositionProduct) GetQuantity() float64 {
+func (processingPositionProduct ProcessingPositionProduct) GetQuantity() Decimal {
 	return Deref(processingPositionProduct.Quantity)
 }
 
@@ -531,7 +538,7 @@ func (processingPositionProduct *ProcessingPositionProduct) SetAssortment(assort
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (processingPositionProduct *ProcessingPositionProduct) SetQuantity(quantity float64) *ProcessingPositionProduct {
+func (processingPositionProduct *ProcessingPositionProduct) SetQuantity(quantity Decimal) *ProcessingPositionProduct {
 	processingPositionProduct.Quantity = &quantity
 	return processingPositionProduct
 }
@@ -558,6 +565,11 @@ type ProcessingService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Processing], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех техопераций постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Processing, error]
+
 	// Create выполняет запрос на создание техоперации.
 	// Обязательные для создания поля с привязкой техкарты:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -36,7 +37,7 @@ type ProcessingOrder struct {
 	ProcessingPlan        *ProcessingPlan                     `json:"processingPlan,omitempty"`        // Метаданные Техкарты
 	Project               *NullValue[Project]                 `json:"project,omitempty"`               // Метаданные проекта
 	Applicable            *bool                               `json:"applicable,omitempty"`            // Отметка о проведении
-	Quantity              *float64                            `json:"quantity,omitempty"`              // Объем производства
+	Quantity              *Decimal                            `json:"quantity,omitempty"`              // Объем производства
 	Shared                *bool                               `json:"shared,omitempty"`                // Общий доступ
 	State                 *NullValue[State]                   `json:"state,omitempty"`                 // Метаданные статуса Заказа на производство
 	Store                 *Store                              `json:"store,omitempty"`                 // Метаданные склада
@@ -44,6 +45,8 @@ type ProcessingOrder struct {
 	Updated               *Timestamp                          `json:"updated,omitempty"`               // Момент последнего обновления Заказа на производство
 	Processings           Slice[Processing]                   `json:"processings,omitempty"`           // Массив ссылок на связанные техоперации
 	Attributes            Slice[Attribute]                    `json:"attributes,omitempty"`            // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -167,7 +170,7 @@ func (processingOrder ProcessingOrder) GetApplicable() bool {
 }
 
 // GetQuantity возвращает Объем производства.
-func (processingOrder ProcessingOrder) GetQuantity() float64 {
+func (processingOrder ProcessingOrder) GetQuantity() Decimal {
 	return Deref(processingOrder.Quantity)
 }
 
@@ -311,7 +314,7 @@ func (processingOrder *ProcessingOrder) SetApplicable(applicable bool) *Processi
 }
 
 // SetQuantity устанавливает Объем производства.
-func (processingOrder *ProcessingOrder) SetQuantity(quantity float64) *ProcessingOrder {
+func (processingOrder *ProcessingOrder) SetQuantity(quantity Decimal) *ProcessingOrder {
 	processingOrder.Quantity = &quantity
 	return processingOrder
 }
@@ -397,8 +400,10 @@ type ProcessingOrderPosition struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-	Reserve    *float64            `json:"reserve,omitempty"`    // Резерв данной позиции
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Reserve    *Decimal            `json:"reserve,omitempty"`    // Резерв данной позиции
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -425,12 +430,12 @@ func (processingOrderPosition ProcessingOrderPosition) GetPack() Pack {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (processingOrderPosition ProcessingOrderPosition) GetQuantity() float64 {
+func (processingOrderPosition ProcessingOrderPosition) GetQuantity() Decimal {
 	return Deref(processingOrderPosition.Quantity)
 }
 
 // GetReserve возвращает Резерв данной позиции.
-func (processingOrderPosition ProcessingOrderPosition) GetReserve() float64 {
+func (processingOrderPosition ProcessingOrderPosition) GetReserve() Decimal {
 	return Deref(processingOrderPosition.Reserve)
 }
 
@@ -456,13 +461,13 @@ func (processingOrderPosition *ProcessingOrderPosition) SetPack(pack *Pack) *Pro
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (processingOrderPosition *ProcessingOrderPosition) SetQuantity(quantity float64) *ProcessingOrderPosition {
+func (processingOrderPosition *ProcessingOrderPosition) SetQuantity(quantity Decimal) *ProcessingOrderPosition {
 	processingOrderPosition.Quantity = &quantity
 	return processingOrderPosition
 }
 
 // SetReserve устанавливает Резерв данной позиции.
-func (processingOrderPosition *ProcessingOrderPosition) SetReserve(reserve float64) *ProcessingOrderPosition {
+func (processingOrderPosition *ProcessingOrderPosition) SetReserve(reserve Decimal) *ProcessingOrderPosition {
 	processingOrderPosition.Reserve = &reserve
 	return processingOrderPosition
 }
@@ -489,6 +494,11 @@ type ProcessingOrderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[ProcessingOrder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех заказов на производство постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProcessingOrder, error]
+
 	// Create выполняет запрос на создание заказа на производство.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -553,6 +563,11 @@ type ProcessingOrderService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[ProcessingOrderPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*ProcessingOrderPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type ProcessingPlan struct {
 	AccountID            *string                            `json:"accountId,omitempty"`            // ID учётной записи            // ID учётной записи
 	Archived             *bool                              `json:"archived,omitempty"`             // Добавлена ли Тех. карта в архив
 	Code                 *string                            `json:"code,omitempty"`                 // Код Тех. карты
-	Cost                 *float64                           `json:"cost,omitempty"`                 // Стоимость производства
+	Cost                 *Decimal                           `json:"cost,omitempty"`                 // Стоимость производства
 	ExternalCode         *string                            `json:"externalCode,omitempty"`         // Внешний код
 	Group                *Group                             `json:"group,omitempty"`                // Отдел сотрудника                // Отдел сотрудника
 	ID                   *string                            `json:"id,omitempty"`                   // ID сущности
@@ -35,6 +36,8 @@ type ProcessingPlan struct {
 	Shared               *bool                              `json:"shared,omitempty"`               // Общий доступ               // Общий доступ
 	Updated              *Timestamp                         `json:"updated,omitempty"`              // Момент последнего обновления
 	CostDistributionType CostDistributionType               `json:"costDistributionType,omitempty"` // Тип распределения себестоимости. Возможные значения: BY_PRICE, BY_PRODUCTION
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -59,7 +62,7 @@ func (processingPlan ProcessingPlan) GetCode() string {
 	return Deref(processingPlan.Code)
 }
 
-func (processingPlan ProcessingPlan) GetCost() float64 {
+func (processingPlan ProcessingPlan) GetCost() Decimal {
 	return Deref(processingPlan.Cost)
 }
 
@@ -133,7 +136,7 @@ func (processingPlan *ProcessingPlan) SetCode(code string) *ProcessingPlan {
 	return processingPlan
 }
 
-func (processingPlan *ProcessingPlan) SetCost(cost float64) *ProcessingPlan {
+func (processingPlan *ProcessingPlan) SetCost(cost Decimal) *ProcessingPlan {
 	processingPlan.Cost = &cost
 	return processingPlan
 }
@@ -226,10 +229,12 @@ func (processingPlan *ProcessingPlan) Delete(ctx context.Context, client *Client
 type ProcessingPlanStages struct {
 	AccountID                 *string  `json:"accountId,omitempty"`                 // ID учётной записи                 // ID учётной записи
 	ID                        *string  `json:"id,omitempty"`                        // ID Материала
-	Cost                      *float64 `json:"cost,omitempty"`                      // Стоимость производства, на определенном этапе
-	LabourCost                *float64 `json:"labourCost,omitempty"`                // Оплата труда, на определенном этапе
+	Cost                      *Decimal `json:"cost,omitempty"`                      // Стоимость производства, на определенном этапе
+	LabourCost                *Decimal `json:"labourCost,omitempty"`                // Оплата труда, на определенном этапе
 	StandardHour              *float64 `json:"standardHour,omitempty"`              // Нормо-часы, на определенном этапе
 	ProcessingProcessPosition *Meta    `json:"processingProcessPosition,omitempty"` // Метаданные позиции техпроцесса
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (processingPlanStages ProcessingPlanStages) GetAccountID() string {
@@ -240,11 +245,11 @@ func (processingPlanStages ProcessingPlanStages) GetID() string {
 	return Deref(processingPlanStages.ID)
 }
 
-func (processingPlanStages ProcessingPlanStages) GetCost() float64 {
+func (processingPlanStages ProcessingPlanStages) GetCost() Decimal {
 	return Deref(processingPlanStages.Cost)
 }
 
-func (processingPlanStages ProcessingPlanStages) GetLabourCost() float64 {
+func (processingPlanStages ProcessingPlanStages) GetLabourCost() Decimal {
 	return Deref(processingPlanStages.LabourCost)
 }
 
@@ -256,12 +261,12 @@ func (processingPlanStages ProcessingPlanStages) GetProcessingProcessPosition()
 	return Deref(processingPlanStages.ProcessingProcessPosition)
 }
 
-func (processingPlanStages *ProcessingPlanStages) SetCost(cost float64) *ProcessingPlanStages {
+func (processingPlanStages *ProcessingPlanStages) SetCost(cost Decimal) *ProcessingPlanStages {
 	processingPlanStages.Cost = &cost
 	return processingPlanStages
 }
 
-func (processingPlanStages *ProcessingPlanStages) SetLabourCost(labourCost float64) *ProcessingPlanStages {
+func (processingPlanStages *ProcessingPlanStages) SetLabourCost(labourCost Decimal) *ProcessingPlanStages {
 	processingPlanStages.LabourCost = &labourCost
 	return processingPlanStages
 }
@@ -292,7 +297,9 @@ type ProcessingPlanProduct struct {
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара или модификации позиции
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Product    *Product            `json:"product,omitempty"`    // Метаданные товара позиции. В случае, если в поле assortment указана модификация, то это поле содержит товар, к которому относится эта модификация
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров данного вида в позиции
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (processingPlanProduct ProcessingPlanProduct) GetAccountID() string {
@@ -311,7 +318,7 @@ func (processingPlanProduct ProcessingPlanProduct) GetProduct() Product {
 	return Deref(processingPlanProduct.Product)
 }
 
-func (processingPlanProduct ProcessingPlanProduct) GetQuantity() float64 {
+func (processingPlanProduct ProcessingPlanProduct) GetQuantity() Decimal {
 	return Deref(processingPlanProduct.Quantity)
 }
 
@@ -326,7 +333,7 @@ func (processingPlanProduct *ProcessingPlanProduct) SetProduct(product *Product)
 	return processingPlanProduct
 }
 
-func (processingPlanProduct *ProcessingPlanProduct) SetQuantity(quantity float64) *ProcessingPlanProduct {
+func (processingPlanProduct *ProcessingPlanProduct) SetQuantity(quantity Decimal) *ProcessingPlanProduct {
 	processingPlanProduct.Quantity = &quantity
 	return processingPlanProduct
 }
@@ -352,9 +359,11 @@ type ProcessingPlanMaterial struct {
 	Assortment                *AssortmentPosition `json:"assortment,omitempty"`                // Метаданные товара или модификации позиции
 	ID                        *string             `json:"id,omitempty"`                        // ID позиции
 	Product                   *Product            `json:"product,omitempty"`                   // Метаданные товара позиции. В случае, если в поле assortment указана модификация, то это поле содержит товар, к которому относится эта модификация
-	Quantity                  *float64            `json:"quantity,omitempty"`                  // Количество товаров данного вида в позиции
+	Quantity                  *Decimal            `json:"quantity,omitempty"`                  // Количество товаров данного вида в позиции
 	ProcessingProcessPosition *Meta               `json:"processingProcessPosition,omitempty"` // Метаданные позиции Тех. процесса
 	MaterialProcessingPlan    *Meta               `json:"materialProcessingPlan"`              // Метаданные техкарты материала [11-01-2024]
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (processingPlanMaterial ProcessingPlanMaterial) GetAccountID() string {
@@ -373,7 +382,7 @@ func (processingPlanMaterial ProcessingPlanMaterial) GetProduct() Product {
 	return Deref(processingPlanMaterial.Product)
 }
 
-func (processingPlanMaterial ProcessingPlanMaterial) GetQuantity() float64 {
+func (processingPlanMaterial ProcessingPlanMaterial) GetQuantity() Decimal {
 	return Deref(processingPlanMaterial.Quantity)
 }
 
@@ -396,7 +405,7 @@ func (processingPlanMaterial *ProcessingPlanMaterial) SetProduct(product *Produc
 	return processingPlanMaterial
 }
 
-func (processingPlanMaterial *ProcessingPlanMaterial) SetQuantity(quantity float64) *ProcessingPlanMaterial {
+func (processingPlanMaterial *ProcessingPlanMaterial) SetQuantity(quantity Decimal) *ProcessingPlanMaterial {
 	processingPlanMaterial.Quantity = &quantity
 	return processingPlanMaterial
 }
@@ -445,6 +454,11 @@ type ProcessingPlanService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[ProcessingPlanProduct], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*ProcessingPlanProduct, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -28,6 +28,8 @@ type ProcessingPlanFolder struct {
 	PathName     *string    `json:"pathName,omitempty"`     // Наименование Группы тех. карт, в которую входит данная Группа тех. карт
 	Shared       *bool      `json:"shared,omitempty"`       // Общий доступ       // Общий доступ
 	Updated      *Timestamp `json:"updated,omitempty"`      // Момент последнего обновления сущности
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -27,6 +28,8 @@ type ProcessingProcess struct {
 	Positions    *MetaArray[ProcessingProcessPosition] `json:"positions,omitempty"`    // Метаданные позиций Тех. процесса
 	Shared       *bool                                 `json:"shared,omitempty"`       // Общий доступ       // Общий доступ
 	Updated      *Timestamp                            `json:"updated,omitempty"`      // Момент последнего обновления сущности
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -169,6 +172,8 @@ type ProcessingProcessPosition struct {
 	Meta            *Meta                                 `json:"meta,omitempty"`            // Метаданные позиции Тех. процесса
 	ProcessingStage *ProcessingStage                      `json:"processingstage,omitempty"` // Метаданные этапа, который представляет собой позиция
 	NextPositions   *MetaArray[ProcessingProcessPosition] `json:"nextPositions,omitempty"`   // Метаданные следующих позиций позиции Техпроцесса
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -243,6 +248,11 @@ type ProcessingProcessService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[ProcessingProcessPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*ProcessingProcessPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
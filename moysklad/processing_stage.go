@@ -28,6 +28,8 @@ type ProcessingStage struct {
 	Performers    *MetaArray[Employee] `json:"performers,omitempty"`    // Метаданные возможных исполнителей
 	Shared        *bool                `json:"shared,omitempty"`        // Общий доступ        // Общий доступ
 	Updated       *Timestamp           `json:"updated,omitempty"`       // Момент последнего обновления сущности
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
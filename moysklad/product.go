@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -49,7 +50,7 @@ type Product struct {
 	ProductFolder       *NullValue[ProductFolder] `json:"productFolder,omitempty"`       // Метаданные группы Товара
 	SalePrices          Slice[SalePrice]          `json:"salePrices,omitempty"`          // Цены продажи
 	Shared              *bool                     `json:"shared,omitempty"`              // Общий доступ
-	MinimumBalance      *float64                  `json:"minimumBalance,omitempty"`      // Неснижаемый остаток
+	MinimumBalance      *Decimal                  `json:"minimumBalance,omitempty"`      // Неснижаемый остаток
 	SyncID              *string                   `json:"syncId,omitempty"`              // ID синхронизации
 	AccountID           *string                   `json:"accountId,omitempty"`           // ID учётной записи
 	Things              Slice[string]             `json:"things,omitempty"`              // Серийные номера
@@ -63,6 +64,8 @@ type Product struct {
 	TrackingType        TrackingType              `json:"trackingType,omitempty"`        // Тип маркируемой продукции
 	Volume              *float64                  `json:"volume,omitempty"`              // Объем
 	Attributes          Slice[Attribute]          `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -293,7 +296,7 @@ func (product Product) GetShared() bool {
 }
 
 // GetMinimumBalance возвращает Неснижаемый остаток.
-func (product Product) GetMinimumBalance() float64 {
+func (product Product) GetMinimumBalance() Decimal {
 	return Deref(product.MinimumBalance)
 }
 
@@ -585,7 +588,7 @@ func (product *Product) SetShared(shared bool) *Product {
 }
 
 // SetMinimumBalance устанавливает Неснижаемый остаток.
-func (product *Product) SetMinimumBalance(minimumBalance float64) *Product {
+func (product *Product) SetMinimumBalance(minimumBalance Decimal) *Product {
 	product.MinimumBalance = &minimumBalance
 	return product
 }
@@ -762,6 +765,11 @@ type ProductService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Product], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех товаров постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Product, error]
+
 	// Create выполняет запрос на создание товара.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование товара)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -35,6 +36,8 @@ type ProductFolder struct {
 	Meta                *Meta                     `json:"meta,omitempty"`                // Метаданные Группы товаров
 	Vat                 *int                      `json:"vat,omitempty"`                 // НДС %
 	TaxSystem           TaxSystem                 `json:"taxSystem,omitempty"`           // Код системы налогообложения
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -294,6 +297,11 @@ type ProductFolderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[ProductFolder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех групп товаров постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProductFolder, error]
+
 	// Create выполняет запрос на создание группы товаров.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование группы товаров)
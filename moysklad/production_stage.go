@@ -17,18 +17,20 @@ type ProductionStage struct {
 	AccountID          *string                            `json:"accountId,omitempty"`          // ID учётной записи
 	ID                 *string                            `json:"id,omitempty"`                 // ID Производственного этапа
 	Meta               *Meta                              `json:"meta,omitempty"`               // Метаданные Производственного этапа
-	LabourUnitCost     *float64                           `json:"labourUnitCost,omitempty"`     // Затраты на оплату труда за единицу объема производства
+	LabourUnitCost     *Decimal                           `json:"labourUnitCost,omitempty"`     // Затраты на оплату труда за единицу объема производства
 	Materials          *MetaArray[ProductionTaskMaterial] `json:"materials,omitempty"`          // Метаданные Материалов производственного этапа
 	OrderingPosition   *int                               `json:"orderingPosition,omitempty"`   // Индекс Производственного этапа в Позиции производственного задания
 	Stage              *ProductionStage                   `json:"stage,omitempty"`              // Метаданные Этапа производства
 	ProductionRow      *ProductionRow                     `json:"productionRow,omitempty"`      // Метаданные Позиции производственного задания
-	TotalQuantity      *float64                           `json:"totalQuantity,omitempty"`      // Объем Производственного этапа. Соответствует объему Позиции производственного задания
-	CompletedQuantity  *float64                           `json:"completedQuantity,omitempty"`  // Выполненное количество
-	AvailableQuantity  *float64                           `json:"availableQuantity,omitempty"`  // Количество, доступное к выполнению
-	BlockedQuantity    *float64                           `json:"blockedQuantity,omitempty"`    // Количество, которое на данный момент выполнять нельзя. Например, ещё не выполнен предыдущий этап
-	SkippedQuantity    *float64                           `json:"skippedQuantity,omitempty"`    // Количество, которое не будет выполнено. Например, из-за остановки производства
-	ProcessingUnitCost *float64                           `json:"processingUnitCost,omitempty"` // Затраты на единицу объема производства
+	TotalQuantity      *Decimal                           `json:"totalQuantity,omitempty"`      // Объем Производственного этапа. Соответствует объему Позиции производственного задания
+	CompletedQuantity  *Decimal                           `json:"completedQuantity,omitempty"`  // Выполненное количество
+	AvailableQuantity  *Decimal                           `json:"availableQuantity,omitempty"`  // Количество, доступное к выполнению
+	BlockedQuantity    *Decimal                           `json:"blockedQuantity,omitempty"`    // Количество, которое на данный момент выполнять нельзя. Например, ещё не выполнен предыдущий этап
+	SkippedQuantity    *Decimal                           `json:"skippedQuantity,omitempty"`    // Количество, которое не будет выполнено. Например, из-за остановки производства
+	ProcessingUnitCost *Decimal                           `json:"processingUnitCost,omitempty"` // Затраты на единицу объема производства
 	StandardHourUnit   *float64                           `json:"standardHourUnit,omitempty"`   // Нормо-часы единицы объема производства
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -53,7 +55,7 @@ func (productionStage ProductionStage) GetMeta() Meta {
 	return Deref(productionStage.Meta)
 }
 
-func (productionStage ProductionStage) GetLabourUnitCost() float64 {
+func (productionStage ProductionStage) GetLabourUnitCost() Decimal {
 	return Deref(productionStage.LabourUnitCost)
 }
 
@@ -73,27 +75,27 @@ func (productionStage ProductionStage) GetProductionRow() ProductionRow {
 	return Deref(productionStage.ProductionRow)
 }
 
-func (productionStage ProductionStage) GetTotalQuantity() float64 {
+func (productionStage ProductionStage) GetTotalQuantity() Decimal {
 	return Deref(productionStage.TotalQuantity)
 }
 
-func (productionStage ProductionStage) GetCompletedQuantity() float64 {
+func (productionStage ProductionStage) GetCompletedQuantity() Decimal {
 	return Deref(productionStage.CompletedQuantity)
 }
 
-func (productionStage ProductionStage) GetAvailableQuantity() float64 {
+func (productionStage ProductionStage) GetAvailableQuantity() Decimal {
 	return Deref(productionStage.AvailableQuantity)
 }
 
-func (productionStage ProductionStage) GetBlockedQuantity() float64 {
+func (productionStage ProductionStage) GetBlockedQuantity() Decimal {
 	return Deref(productionStage.BlockedQuantity)
 }
 
-func (productionStage ProductionStage) GetSkippedQuantity() float64 {
+func (productionStage ProductionStage) GetSkippedQuantity() Decimal {
 	return Deref(productionStage.SkippedQuantity)
 }
 
-func (productionStage ProductionStage) GetProcessingUnitCost() float64 {
+func (productionStage ProductionStage) GetProcessingUnitCost() Decimal {
 	return Deref(productionStage.ProcessingUnitCost)
 }
 
@@ -106,7 +108,7 @@ func (productionStage *ProductionStage) SetMeta(meta *Meta) *ProductionStage {
 	return productionStage
 }
 
-func (productionStage *ProductionStage) SetLabourUnitCost(labourUnitCost float64) *ProductionStage {
+func (productionStage *ProductionStage) SetLabourUnitCost(labourUnitCost Decimal) *ProductionStage {
 	productionStage.LabourUnitCost = &labourUnitCost
 	return productionStage
 }
@@ -116,7 +118,7 @@ func (productionStage *ProductionStage) SetMaterials(materials ...*ProductionTas
 	return productionStage
 }
 
-func (productionStage *ProductionStage) SetProcessingUnitCost(processingUnitCost float64) *ProductionStage {
+func (productionStage *ProductionStage) SetProcessingUnitCost(processingUnitCost Decimal) *ProductionStage {
 	productionStage.ProcessingUnitCost = &processingUnitCost
 	return productionStage
 }
@@ -146,7 +148,9 @@ type ProductionTaskMaterial struct {
 	AccountID    *string             `json:"accountId,omitempty"`    // ID учётной записи
 	Assortment   *AssortmentPosition `json:"assortment,omitempty"`   // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	ID           *string             `json:"id,omitempty"`           // ID позиции
-	PlanQuantity *float64            `json:"planQuantity,omitempty"` // Количество товаров/модификаций данного вида в позиции
+	PlanQuantity *Decimal            `json:"planQuantity,omitempty"` // Количество товаров/модификаций данного вида в позиции
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (productionTaskMaterial ProductionTaskMaterial) GetAccountID() string {
@@ -161,7 +165,7 @@ func (productionTaskMaterial ProductionTaskMaterial) GetID() string {
 	return Deref(productionTaskMaterial.ID)
 }
 
-func (productionTaskMaterial ProductionTaskMaterial) GetPlanQuantity() float64 {
+func (productionTaskMaterial ProductionTaskMaterial) GetPlanQuantity() Decimal {
 	return Deref(productionTaskMaterial.PlanQuantity)
 }
 
@@ -171,7 +175,7 @@ func (productionTaskMaterial *ProductionTaskMaterial) SetAssortment(assortment A
 	return productionTaskMaterial
 }
 
-func (productionTaskMaterial *ProductionTaskMaterial) SetPlanQuantity(planQuantity float64) *ProductionTaskMaterial {
+func (productionTaskMaterial *ProductionTaskMaterial) SetPlanQuantity(planQuantity Decimal) *ProductionTaskMaterial {
 	productionTaskMaterial.PlanQuantity = &planQuantity
 	return productionTaskMaterial
 }
@@ -213,7 +217,7 @@ type productionStageService struct {
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-proizwodstwennoe-zadanie-poluchit-spisok-proizwodstwennyh-atapow-proizwodstwennogo-zadaniq
 func (service *productionStageService) GetProductStages(ctx context.Context, productionTaskID string, params ...func(*Params)) (*MetaArray[ProductionStage], *resty.Response, error) {
-	ptURL := fmt.Sprintf("https://api.moysklad.ru/api/remap/1.2/entity/productiontask/%s", productionTaskID)
+	ptURL := newMeta(MetaTypeProductionTask, productionTaskID).GetHref()
 
 	params = append(params, WithFilterEquals("productionTask", ptURL))
 
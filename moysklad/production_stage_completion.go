@@ -21,7 +21,7 @@ type ProductionStageCompletion struct {
 	ExternalCode       *string                                       `json:"externalCode,omitempty"`       // Внешний код Выполнения этапа производства
 	Group              *Group                                        `json:"group,omitempty"`              // Отдел сотрудника              // Отдел сотрудника
 	ID                 *string                                       `json:"id,omitempty"`                 // ID Выполнения этапа производства
-	LabourUnitCost     *float64                                      `json:"labourUnitCost,omitempty"`     // Оплата труда за единицу объема производства
+	LabourUnitCost     *Decimal                                      `json:"labourUnitCost,omitempty"`     // Оплата труда за единицу объема производства
 	StandardHourUnit   *float64                                      `json:"standardHourUnit,omitempty"`   // Нормо-часы единицы объема производства
 	Materials          *MetaArray[ProductionStageCompletionMaterial] `json:"materials,omitempty"`          // Метаданные Материалов выполнения этапа производства
 	Meta               *Meta                                         `json:"meta,omitempty"`               // Метаданные Выполнения этапа производства
@@ -29,12 +29,14 @@ type ProductionStageCompletion struct {
 	Name               *string                                       `json:"name,omitempty"`               // Наименование Выполнения этапа производства
 	Owner              *Employee                                     `json:"owner,omitempty"`              // Метаданные владельца (Сотрудника)              // Владелец (Сотрудник)
 	Performer          *Employee                                     `json:"performer,omitempty"`          // Исполнитель (Сотрудник)
-	ProcessingUnitCost *float64                                      `json:"processingUnitCost,omitempty"` // Затраты на единицу объема производства
+	ProcessingUnitCost *Decimal                                      `json:"processingUnitCost,omitempty"` // Затраты на единицу объема производства
 	ProductionStage    *ProductionStage                              `json:"productionStage,omitempty"`    // Производственный этап
-	ProductionVolume   *float64                                      `json:"productionVolume,omitempty"`   // Объем производства
+	ProductionVolume   *Decimal                                      `json:"productionVolume,omitempty"`   // Объем производства
 	Products           *MetaArray[ProductionStageCompletionResult]   `json:"products,omitempty"`           // Метаданные Продуктов выполнения этапа производства. Есть только у последнего этапа
 	Shared             *bool                                         `json:"shared,omitempty"`             // Общий доступ             // Общий доступ
 	Updated            *Timestamp                                    `json:"updated,omitempty"`            // Момент последнего обновления Выполнения этапа производства
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -67,7 +69,7 @@ func (productionStageCompletion ProductionStageCompletion) GetID() string {
 	return Deref(productionStageCompletion.ID)
 }
 
-func (productionStageCompletion ProductionStageCompletion) GetLabourUnitCost() float64 {
+func (productionStageCompletion ProductionStageCompletion) GetLabourUnitCost() Decimal {
 	return Deref(productionStageCompletion.LabourUnitCost)
 }
 
@@ -99,7 +101,7 @@ func (productionStageCompletion ProductionStageCompletion) GetPerformer() Employ
 	return Deref(productionStageCompletion.Performer)
 }
 
-func (productionStageCompletion ProductionStageCompletion) GetProcessingUnitCost() float64 {
+func (productionStageCompletion ProductionStageCompletion) GetProcessingUnitCost() Decimal {
 	return Deref(productionStageCompletion.ProcessingUnitCost)
 }
 
@@ -107,7 +109,7 @@ func (productionStageCompletion ProductionStageCompletion) GetProductionStage()
 	return Deref(productionStageCompletion.ProductionStage)
 }
 
-func (productionStageCompletion ProductionStageCompletion) GetProductionVolume() float64 {
+func (productionStageCompletion ProductionStageCompletion) GetProductionVolume() Decimal {
 	return Deref(productionStageCompletion.ProductionVolume)
 }
 
@@ -133,7 +135,7 @@ func (productionStageCompletion *ProductionStageCompletion) SetGroup(group *Grou
 	return productionStageCompletion
 }
 
-func (productionStageCompletion *ProductionStageCompletion) SetLabourUnitCost(labourUnitCost float64) *ProductionStageCompletion {
+func (productionStageCompletion *ProductionStageCompletion) SetLabourUnitCost(labourUnitCost Decimal) *ProductionStageCompletion {
 	productionStageCompletion.LabourUnitCost = &labourUnitCost
 	return productionStageCompletion
 }
@@ -173,7 +175,7 @@ func (productionStageCompletion *ProductionStageCompletion) SetPerformer(perform
 	return productionStageCompletion
 }
 
-func (productionStageCompletion *ProductionStageCompletion) SetProcessingUnitCost(processingUnitCost float64) *ProductionStageCompletion {
+func (productionStageCompletion *ProductionStageCompletion) SetProcessingUnitCost(processingUnitCost Decimal) *ProductionStageCompletion {
 	productionStageCompletion.ProcessingUnitCost = &processingUnitCost
 	return productionStageCompletion
 }
@@ -183,7 +185,7 @@ func (productionStageCompletion *ProductionStageCompletion) SetProductionStage(p
 	return productionStageCompletion
 }
 
-func (productionStageCompletion *ProductionStageCompletion) SetProductionVolume(productionVolume float64) *ProductionStageCompletion {
+func (productionStageCompletion *ProductionStageCompletion) SetProductionVolume(productionVolume Decimal) *ProductionStageCompletion {
 	productionStageCompletion.ProductionVolume = &productionVolume
 	return productionStageCompletion
 }
@@ -232,9 +234,11 @@ func (productionStageCompletion *ProductionStageCompletion) Delete(ctx context.C
 type ProductionStageCompletionMaterial struct {
 	AccountID        *string             `json:"accountId,omitempty"`        // ID учётной записи        // ID учётной записи
 	Assortment       *AssortmentPosition `json:"assortment,omitempty"`       // Метаданные товара/модификации/серии, которую представляет собой позиция
-	ConsumedQuantity *float64            `json:"consumedQuantity,omitempty"` // Количество товаров/модификаций данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе
+	ConsumedQuantity *Decimal            `json:"consumedQuantity,omitempty"` // Количество товаров/модификаций данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе
 	ID               *string             `json:"id,omitempty"`               // ID позиции
 	Things           Slice[string]       `json:"things,omitempty"`           // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (productionStageCompletionMaterial ProductionStageCompletionMaterial) GetAccountID() string {
@@ -245,7 +249,7 @@ func (productionStageCompletionMaterial ProductionStageCompletionMaterial) GetAs
 	return Deref(productionStageCompletionMaterial.Assortment)
 }
 
-func (productionStageCompletionMaterial ProductionStageCompletionMaterial) GetConsumedQuantity() float64 {
+func (productionStageCompletionMaterial ProductionStageCompletionMaterial) GetConsumedQuantity() Decimal {
 	return Deref(productionStageCompletionMaterial.ConsumedQuantity)
 }
 
@@ -263,7 +267,7 @@ func (productionStageCompletionMaterial *ProductionStageCompletionMaterial) SetA
 	return productionStageCompletionMaterial
 }
 
-func (productionStageCompletionMaterial *ProductionStageCompletionMaterial) SetConsumedQuantity(consumedQuantity float64) *ProductionStageCompletionMaterial {
+func (productionStageCompletionMaterial *ProductionStageCompletionMaterial) SetConsumedQuantity(consumedQuantity Decimal) *ProductionStageCompletionMaterial {
 	productionStageCompletionMaterial.ConsumedQuantity = &consumedQuantity
 	return productionStageCompletionMaterial
 }
@@ -293,8 +297,10 @@ type ProductionStageCompletionResult struct {
 	AccountID        *string             `json:"accountId,omitempty"`        // ID учётной записи        // ID учётной записи
 	Assortment       *AssortmentPosition `json:"assortment,omitempty"`       // Метаданные товара/модификации/серии, которую представляет собой позиция
 	ID               *string             `json:"id,omitempty"`               // ID позиции
-	ProducedQuantity *float64            `json:"producedQuantity,omitempty"` // Количество товаров/модификаций данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе
+	ProducedQuantity *Decimal            `json:"producedQuantity,omitempty"` // Количество товаров/модификаций данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе
 	Things           Slice[string]       `json:"things,omitempty"`           // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (productionStageCompletionResult ProductionStageCompletionResult) GetAccountID() string {
@@ -309,7 +315,7 @@ func (productionStageCompletionResult ProductionStageCompletionResult) GetID() s
 	return Deref(productionStageCompletionResult.ID)
 }
 
-func (productionStageCompletionResult ProductionStageCompletionResult) GetProducedQuantity() float64 {
+func (productionStageCompletionResult ProductionStageCompletionResult) GetProducedQuantity() Decimal {
 	return Deref(productionStageCompletionResult.ProducedQuantity)
 }
 
@@ -323,7 +329,7 @@ func (productionStageCompletionResult *ProductionStageCompletionResult) SetAssor
 	return productionStageCompletionResult
 }
 
-func (productionStageCompletionResult *ProductionStageCompletionResult) SetProducedQuantity(producedQuantity float64) *ProductionStageCompletionResult {
+func (productionStageCompletionResult *ProductionStageCompletionResult) SetProducedQuantity(producedQuantity Decimal) *ProductionStageCompletionResult {
 	productionStageCompletionResult.ProducedQuantity = &producedQuantity
 	return productionStageCompletionResult
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -45,6 +46,8 @@ type ProductionTask struct {
 	Shared                *bool                            `json:"shared,omitempty"` // Общий доступ
 	State                 *NullValue[State]                `json:"state,omitempty"`
 	Attributes            Slice[Attribute]                 `json:"attributes,omitempty"` // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -320,8 +323,10 @@ type ProductionRow struct {
 	ID               *string         `json:"id,omitempty"`               // ID позиции
 	Name             *string         `json:"name,omitempty"`             // Наименование
 	ProcessingPlan   *ProcessingPlan `json:"processingPlan,omitempty"`   // Метаданные Техкарты
-	ProductionVolume *float64        `json:"productionVolume,omitempty"` // Объем производства.
+	ProductionVolume *Decimal        `json:"productionVolume,omitempty"` // Объем производства.
 	Updated          *Timestamp      `json:"updated,omitempty"`          // Момент последнего обновления Производственного задания
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (productionRow ProductionRow) GetAccountID() string {
@@ -344,7 +349,7 @@ func (productionRow ProductionRow) GetProcessingPlan() ProcessingPlan {
 	return Deref(productionRow.ProcessingPlan)
 }
 
-func (productionRow ProductionRow) GetProductionVolume() float64 {
+func (productionRow ProductionRow) GetProductionVolume() Decimal {
 	return Deref(productionRow.ProductionVolume)
 }
 
@@ -367,7 +372,7 @@ func (productionRow *ProductionRow) SetProcessingPlan(processingPlan *Processing
 	return productionRow
 }
 
-func (productionRow *ProductionRow) SetProductionVolume(productionVolume float64) *ProductionRow {
+func (productionRow *ProductionRow) SetProductionVolume(productionVolume Decimal) *ProductionRow {
 	productionRow.ProductionVolume = &productionVolume
 	return productionRow
 }
@@ -389,8 +394,10 @@ type ProductionTaskResult struct {
 	AccountID     *string             `json:"accountId,omitempty"`     // ID учётной записи
 	Assortment    *AssortmentPosition `json:"assortment,omitempty"`    // Ссылка на товар/серию/модификацию, которую представляет собой позиция.
 	ID            *string             `json:"id,omitempty"`            // ID позиции
-	PlanQuantity  *float64            `json:"planQuantity,omitempty"`  // Запланированное для производства количество продукта
+	PlanQuantity  *Decimal            `json:"planQuantity,omitempty"`  // Запланированное для производства количество продукта
 	ProductionRow *ProductionRow      `json:"productionRow,omitempty"` // Метаданные Позиции производственного задания
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 func (productionTaskResult ProductionTaskResult) GetAccountID() string {
@@ -405,7 +412,7 @@ func (productionTaskResult ProductionTaskResult) GetID() string {
 	return Deref(productionTaskResult.ID)
 }
 
-func (productionTaskResult ProductionTaskResult) GetPlanQuantity() float64 {
+func (productionTaskResult ProductionTaskResult) GetPlanQuantity() Decimal {
 	return Deref(productionTaskResult.PlanQuantity)
 }
 
@@ -419,7 +426,7 @@ func (productionTaskResult *ProductionTaskResult) SetAssortment(assortment Assor
 	return productionTaskResult
 }
 
-func (productionTaskResult *ProductionTaskResult) SetPlanQuantity(planQuantity float64) *ProductionTaskResult {
+func (productionTaskResult *ProductionTaskResult) SetPlanQuantity(planQuantity Decimal) *ProductionTaskResult {
 	productionTaskResult.PlanQuantity = &planQuantity
 	return productionTaskResult
 }
@@ -493,6 +500,11 @@ type ProductionTaskService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[ProductionRow], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*ProductionRow, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
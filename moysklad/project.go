@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -28,6 +29,8 @@ type Project struct {
 	Shared       *bool            `json:"shared,omitempty"`       // Общий доступ
 	Updated      *Timestamp       `json:"updated,omitempty"`      // Момент последнего обновления Проекта
 	Attributes   Slice[Attribute] `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -208,6 +211,11 @@ type ProjectService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Project], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех проектов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Project, error]
+
 	// Create выполняет запрос на создание проекта.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование проекта)
@@ -0,0 +1,189 @@
+// Package prommetrics содержит реализацию [moysklad.Metrics] для Prometheus.
+//
+// # Пример:
+//
+//	collector := prommetrics.New(prommetrics.Options{})
+//	prometheus.MustRegister(collector)
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:   "MS_TOKEN_HERE",
+//		Metrics: collector,
+//	})
+package prommetrics
+
+import (
+	"github.com/EnOane/go-moysklad/moysklad"
+	"github.com/prometheus/client_golang/prometheus"
+	"strconv"
+	"time"
+)
+
+// Options параметры коллектора.
+type Options struct {
+	// Пространство имён метрик. По умолчанию "moysklad".
+	Namespace string
+
+	// Постоянные метки, добавляемые ко всем метрикам (например, идентификатор учётной записи).
+	ConstLabels prometheus.Labels
+
+	// Границы интервалов гистограммы длительности запросов в секундах.
+	// По умолчанию [prometheus.DefBuckets].
+	Buckets []float64
+}
+
+// Collector реализует интерфейсы [moysklad.Metrics] и [prometheus.Collector].
+//
+// Метрики:
+//   - moysklad_requests_total                 – количество запросов по типу сущности, методу и статусу ответа
+//   - moysklad_request_duration_seconds       – длительность запросов по типу сущности, методу и статусу ответа
+//   - moysklad_ratelimit_remaining            – последнее значение заголовка X-RateLimit-Remaining
+//   - moysklad_limiter_wait_seconds           – время ожидания разрешения ограничителя запросов
+//   - moysklad_retries_total                  – количество повторных попыток по типу сущности и методу
+//   - moysklad_inflight_requests              – количество выполняемых запросов
+//   - moysklad_inflight_requests_limit        – ограничение количества параллельных запросов (MaxQueriesPerUser)
+//   - moysklad_async_polls_total              – количество проверок статуса асинхронных задач
+type Collector struct {
+	requests       *prometheus.CounterVec
+	duration       *prometheus.HistogramVec
+	remaining      prometheus.Gauge
+	limiterWait    prometheus.Histogram
+	retries        *prometheus.CounterVec
+	inFlight       prometheus.Gauge
+	inFlightLimit  prometheus.Gauge
+	asyncPolls     prometheus.Counter
+	collectorsList []prometheus.Collector
+}
+
+// New возвращает новый [Collector].
+//
+// Коллектор необходимо зарегистрировать в [prometheus.Registerer] и передать в конфигурацию клиента.
+func New(options Options) *Collector {
+	namespace := options.Namespace
+	if namespace == "" {
+		namespace = "moysklad"
+	}
+
+	buckets := options.Buckets
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+
+	collector := &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "requests_total",
+			Help:        "Количество запросов к API МойСклад.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"entity", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Длительность запросов к API МойСклад.",
+			ConstLabels: options.ConstLabels,
+			Buckets:     buckets,
+		}, []string{"entity", "method", "status"}),
+		remaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Name:        "ratelimit_remaining",
+			Help:        "Число запросов, которые можно отправить до получения ошибки 429.",
+			ConstLabels: options.ConstLabels,
+		}),
+		limiterWait: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "limiter_wait_seconds",
+			Help:        "Время ожидания разрешения ограничителя запросов.",
+			ConstLabels: options.ConstLabels,
+			Buckets:     buckets,
+		}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "retries_total",
+			Help:        "Количество повторных попыток запросов.",
+			ConstLabels: options.ConstLabels,
+		}, []string{"entity", "method"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Name:        "inflight_requests",
+			Help:        "Количество выполняемых запросов.",
+			ConstLabels: options.ConstLabels,
+		}),
+		inFlightLimit: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Name:        "inflight_requests_limit",
+			Help:        "Ограничение количества параллельных запросов от одного пользователя.",
+			ConstLabels: options.ConstLabels,
+		}),
+		asyncPolls: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "async_polls_total",
+			Help:        "Количество проверок статуса асинхронных задач.",
+			ConstLabels: options.ConstLabels,
+		}),
+	}
+
+	collector.inFlightLimit.Set(moysklad.MaxQueriesPerUser)
+
+	collector.collectorsList = []prometheus.Collector{
+		collector.requests,
+		collector.duration,
+		collector.remaining,
+		collector.limiterWait,
+		collector.retries,
+		collector.inFlight,
+		collector.inFlightLimit,
+		collector.asyncPolls,
+	}
+
+	return collector
+}
+
+// Describe реализует интерфейс [prometheus.Collector].
+func (collector *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range collector.collectorsList {
+		c.Describe(ch)
+	}
+}
+
+// Collect реализует интерфейс [prometheus.Collector].
+func (collector *Collector) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range collector.collectorsList {
+		c.Collect(ch)
+	}
+}
+
+// ObserveRequest реализует интерфейс [moysklad.Metrics].
+func (collector *Collector) ObserveRequest(entity, method string, status int, duration time.Duration) {
+	statusLabel := strconv.Itoa(status)
+	collector.requests.WithLabelValues(entity, method, statusLabel).Inc()
+	collector.duration.WithLabelValues(entity, method, statusLabel).Observe(duration.Seconds())
+}
+
+// ObserveRateLimitRemaining реализует интерфейс [moysklad.Metrics].
+func (collector *Collector) ObserveRateLimitRemaining(remaining int) {
+	collector.remaining.Set(float64(remaining))
+}
+
+// ObserveLimiterWait реализует интерфейс [moysklad.Metrics].
+func (collector *Collector) ObserveLimiterWait(duration time.Duration) {
+	collector.limiterWait.Observe(duration.Seconds())
+}
+
+// IncRetry реализует интерфейс [moysklad.Metrics].
+func (collector *Collector) IncRetry(entity, method string) {
+	collector.retries.WithLabelValues(entity, method).Inc()
+}
+
+// AddInFlight реализует интерфейс [moysklad.Metrics].
+func (collector *Collector) AddInFlight(delta int) {
+	collector.inFlight.Add(float64(delta))
+}
+
+// IncAsyncPoll реализует интерфейс [moysklad.Metrics].
+func (collector *Collector) IncAsyncPoll() {
+	collector.asyncPolls.Inc()
+}
+
+var (
+	_ moysklad.Metrics     = (*Collector)(nil)
+	_ prometheus.Collector = (*Collector)(nil)
+)
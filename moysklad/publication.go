@@ -11,6 +11,8 @@ type Publication struct {
 	Meta     *Meta     `json:"meta,omitempty"`     // Метаданные Публикации
 	Template *Template `json:"template,omitempty"` // Метаданные Шаблона печати
 	Href     *string   `json:"href,omitempty"`     // Ссылка на страницу Публикации
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetMeta возвращает Метаданные Публикации.
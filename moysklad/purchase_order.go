@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -15,7 +16,7 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-zakaz-postawschiku
 type PurchaseOrder struct {
-	PayedSum              *float64                          `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
+	PayedSum              *Decimal                          `json:"payedSum,omitempty"`              // Сумма входящих платежей по Заказу
 	Applicable            *bool                             `json:"applicable,omitempty"`            // Отметка о проведении
 	AgentAccount          *AgentAccount                     `json:"agentAccount,omitempty"`          // Метаданные счета контрагента
 	Owner                 *Employee                         `json:"owner,omitempty"`                 // Метаданные владельца (Сотрудника)
@@ -30,7 +31,7 @@ type PurchaseOrder struct {
 	AccountID             *string                           `json:"accountId,omitempty"`             // ID учётной записи
 	Group                 *Group                            `json:"group,omitempty"`                 // Отдел сотрудника
 	ID                    *string                           `json:"id,omitempty"`                    // ID Заказа поставщику
-	InvoicedSum           *float64                          `json:"invoicedSum,omitempty"`           // Сумма счетов поставщику
+	InvoicedSum           *Decimal                          `json:"invoicedSum,omitempty"`           // Сумма счетов поставщику
 	Meta                  *Meta                             `json:"meta,omitempty"`                  // Метаданные Заказа поставщику
 	Moment                *Timestamp                        `json:"moment,omitempty"`                // Дата документа
 	Name                  *string                           `json:"name,omitempty"`                  // Наименование Заказа поставщику
@@ -44,21 +45,23 @@ type PurchaseOrder struct {
 	Published             *bool                             `json:"published,omitempty"`             // Опубликован ли документ
 	Rate                  *NullValue[Rate]                  `json:"rate,omitempty"`                  // Валюта
 	Shared                *bool                             `json:"shared,omitempty"`                // Общий доступ
-	ShippedSum            *float64                          `json:"shippedSum,omitempty"`            // Сумма принятого
+	ShippedSum            *Decimal                          `json:"shippedSum,omitempty"`            // Сумма принятого
 	State                 *NullValue[State]                 `json:"state,omitempty"`                 // Метаданные статуса заказа поставщику
 	Store                 *NullValue[Store]                 `json:"store,omitempty"`                 // Метаданные склада
-	Sum                   *float64                          `json:"sum,omitempty"`                   // Сумма Заказа поставщику в установленной валюте
+	Sum                   *Decimal                          `json:"sum,omitempty"`                   // Сумма Заказа поставщику в установленной валюте
 	SyncID                *string                           `json:"syncId,omitempty"`                // ID синхронизации
 	Updated               *Timestamp                        `json:"updated,omitempty"`               // Момент последнего обновления Заказа поставщику
 	VatEnabled            *bool                             `json:"vatEnabled,omitempty"`            // Учитывается ли НДС
 	VatIncluded           *bool                             `json:"vatIncluded,omitempty"`           // Включен ли НДС в цену
-	VatSum                *float64                          `json:"vatSum,omitempty"`                // Сумма НДС
-	WaitSum               *float64                          `json:"waitSum,omitempty"`               // Сумма товаров в пути
+	VatSum                *Decimal                          `json:"vatSum,omitempty"`                // Сумма НДС
+	WaitSum               *Decimal                          `json:"waitSum,omitempty"`               // Сумма товаров в пути
 	CustomerOrders        Slice[CustomerOrder]              `json:"customerOrders,omitempty"`        // Массив ссылок на связанные заказы покупателей
 	InvoicesIn            Slice[InvoiceIn]                  `json:"invoicesIn,omitempty"`            // Массив ссылок на связанные счета поставщиков
 	Payments              Slice[Payment]                    `json:"payments,omitempty"`              // Массив ссылок на связанные платежи
 	Supplies              Slice[Supply]                     `json:"supplies,omitempty"`              // Массив ссылок на связанные приемки
 	Attributes            Slice[Attribute]                  `json:"attributes,omitempty"`            // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -87,7 +90,7 @@ func (purchaseOrder PurchaseOrder) AsOperationOut() *Operation {
 }
 
 // GetPayedSum возвращает Сумму входящих платежей по Заказу.
-func (purchaseOrder PurchaseOrder) GetPayedSum() float64 {
+func (purchaseOrder PurchaseOrder) GetPayedSum() Decimal {
 	return Deref(purchaseOrder.PayedSum)
 }
 
@@ -162,7 +165,7 @@ func (purchaseOrder PurchaseOrder) GetID() string {
 }
 
 // GetInvoicedSum возвращает Сумму счетов поставщику.
-func (purchaseOrder PurchaseOrder) GetInvoicedSum() float64 {
+func (purchaseOrder PurchaseOrder) GetInvoicedSum() Decimal {
 	return Deref(purchaseOrder.InvoicedSum)
 }
 
@@ -232,7 +235,7 @@ func (purchaseOrder PurchaseOrder) GetShared() bool {
 }
 
 // GetShippedSum возвращает Сумму принятого.
-func (purchaseOrder PurchaseOrder) GetShippedSum() float64 {
+func (purchaseOrder PurchaseOrder) GetShippedSum() Decimal {
 	return Deref(purchaseOrder.ShippedSum)
 }
 
@@ -247,7 +250,7 @@ func (purchaseOrder PurchaseOrder) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Заказа поставщику в установленной валюте.
-func (purchaseOrder PurchaseOrder) GetSum() float64 {
+func (purchaseOrder PurchaseOrder) GetSum() Decimal {
 	return Deref(purchaseOrder.Sum)
 }
 
@@ -272,12 +275,12 @@ func (purchaseOrder PurchaseOrder) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (purchaseOrder PurchaseOrder) GetVatSum() float64 {
+func (purchaseOrder PurchaseOrder) GetVatSum() Decimal {
 	return Deref(purchaseOrder.VatSum)
 }
 
 // GetWaitSum возвращает Сумму товаров в пути.
-func (purchaseOrder PurchaseOrder) GetWaitSum() float64 {
+func (purchaseOrder PurchaseOrder) GetWaitSum() Decimal {
 	return Deref(purchaseOrder.WaitSum)
 }
 
@@ -570,14 +573,16 @@ type PurchaseOrderPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-	Shipped    *float64            `json:"shipped,omitempty"`    // Принято
-	InTransit  *float64            `json:"inTransit,omitempty"`  // Ожидание
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Shipped    *Decimal            `json:"shipped,omitempty"`    // Принято
+	InTransit  *Decimal            `json:"inTransit,omitempty"`  // Ожидание
 	Vat        *float64            `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Wait       *bool               `json:"wait,omitempty"`       // Ожидается данной позиции
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -608,22 +613,22 @@ func (purchaseOrderPosition PurchaseOrderPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (purchaseOrderPosition PurchaseOrderPosition) GetPrice() float64 {
+func (purchaseOrderPosition PurchaseOrderPosition) GetPrice() Decimal {
 	return Deref(purchaseOrderPosition.Price)
 }
 
 // GetQuantity возвращает Количество товаров данного вида в позиции.
-func (purchaseOrderPosition PurchaseOrderPosition) GetQuantity() float64 {
+func (purchaseOrderPosition PurchaseOrderPosition) GetQuantity() Decimal {
 	return Deref(purchaseOrderPosition.Quantity)
 }
 
 // GetShipped возвращает Принято.
-func (purchaseOrderPosition PurchaseOrderPosition) GetShipped() float64 {
+func (purchaseOrderPosition PurchaseOrderPosition) GetShipped() Decimal {
 	return Deref(purchaseOrderPosition.Shipped)
 }
 
 // GetInTransit возвращает Ожидание.
-func (purchaseOrderPosition PurchaseOrderPosition) GetInTransit() float64 {
+func (purchaseOrderPosition PurchaseOrderPosition) GetInTransit() Decimal {
 	return Deref(purchaseOrderPosition.InTransit)
 }
 
@@ -672,25 +677,25 @@ func (purchaseOrderPosition *PurchaseOrderPosition) SetPack(pack *Pack) *Purchas
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (purchaseOrderPosition *PurchaseOrderPosition) SetPrice(price float64) *PurchaseOrderPosition {
+func (purchaseOrderPosition *PurchaseOrderPosition) SetPrice(price Decimal) *PurchaseOrderPosition {
 	purchaseOrderPosition.Price = &price
 	return purchaseOrderPosition
 }
 
 // SetQuantity устанавливает Количество товаров данного вида в позиции.
-func (purchaseOrderPosition *PurchaseOrderPosition) SetQuantity(quantity float64) *PurchaseOrderPosition {
+func (purchaseOrderPosition *PurchaseOrderPosition) SetQuantity(quantity Decimal) *PurchaseOrderPosition {
 	purchaseOrderPosition.Quantity = &quantity
 	return purchaseOrderPosition
 }
 
 // SetShipped устанавливает Принято.
-func (purchaseOrderPosition *PurchaseOrderPosition) SetShipped(shipped float64) *PurchaseOrderPosition {
+func (purchaseOrderPosition *PurchaseOrderPosition) SetShipped(shipped Decimal) *PurchaseOrderPosition {
 	purchaseOrderPosition.Shipped = &shipped
 	return purchaseOrderPosition
 }
 
 // SetInTransit устанавливает Ожидание.
-func (purchaseOrderPosition *PurchaseOrderPosition) SetInTransit(inTransit float64) *PurchaseOrderPosition {
+func (purchaseOrderPosition *PurchaseOrderPosition) SetInTransit(inTransit Decimal) *PurchaseOrderPosition {
 	purchaseOrderPosition.InTransit = &inTransit
 	return purchaseOrderPosition
 }
@@ -735,6 +740,11 @@ type PurchaseOrderService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PurchaseOrder], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех заказов поставщику постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PurchaseOrder, error]
+
 	// Create выполняет запрос на создание заказа поставщику.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -799,6 +809,11 @@ type PurchaseOrderService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PurchaseOrderPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PurchaseOrderPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
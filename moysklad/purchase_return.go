@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -42,18 +43,20 @@ type PurchaseReturn struct {
 	Shared              *bool                              `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]                  `json:"state,omitempty"`               // Метаданные статуса Возврата поставщику
 	Store               *Store                             `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                           `json:"sum,omitempty"`                 // Сумма Возврата поставщику в копейках
+	Sum                 *Decimal                           `json:"sum,omitempty"`                 // Сумма Возврата поставщику в копейках
 	SyncID              *string                            `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                         `json:"updated,omitempty"`             // Момент последнего обновления Возврата поставщику
 	VatEnabled          *bool                              `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	VatIncluded         *bool                              `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	VatSum              *float64                           `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Decimal                           `json:"vatSum,omitempty"`              // Сумма НДС
 	Positions           *MetaArray[PurchaseReturnPosition] `json:"positions,omitempty"`           // Ссылка на позиции Возврата поставщику
 	Owner               *Employee                          `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
 	FactureIn           *FactureIn                         `json:"factureIn,omitempty"`           // Ссылка на Счет-фактуру полученный
 	FactureOut          *FactureOut                        `json:"factureOut,omitempty"`          // Ссылка на Счет-фактуру выданный
-	PayedSum            *float64                           `json:"payedSum,omitempty"`            // Сумма входящих платежей по возврату поставщику
+	PayedSum            *Decimal                           `json:"payedSum,omitempty"`            // Сумма входящих платежей по возврату поставщику
 	Attributes          Slice[Attribute]                   `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -217,7 +220,7 @@ func (purchaseReturn PurchaseReturn) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Возврата поставщику в копейках.
-func (purchaseReturn PurchaseReturn) GetSum() float64 {
+func (purchaseReturn PurchaseReturn) GetSum() Decimal {
 	return Deref(purchaseReturn.Sum)
 }
 
@@ -242,7 +245,7 @@ func (purchaseReturn PurchaseReturn) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (purchaseReturn PurchaseReturn) GetVatSum() float64 {
+func (purchaseReturn PurchaseReturn) GetVatSum() Decimal {
 	return Deref(purchaseReturn.VatSum)
 }
 
@@ -267,7 +270,7 @@ func (purchaseReturn PurchaseReturn) GetFactureOut() FactureOut {
 }
 
 // GetPayedSum возвращает Сумму входящих платежей по возврату поставщику.
-func (purchaseReturn PurchaseReturn) GetPayedSum() float64 {
+func (purchaseReturn PurchaseReturn) GetPayedSum() Decimal {
 	return Deref(purchaseReturn.PayedSum)
 }
 
@@ -481,7 +484,7 @@ func (purchaseReturn *PurchaseReturn) SetFactureOut(factureOut *FactureOut) *Pur
 }
 
 // SetPayedSum устанавливает Сумму входящих платежей по возврату поставщику.
-func (purchaseReturn *PurchaseReturn) SetPayedSum(payedSum float64) *PurchaseReturn {
+func (purchaseReturn *PurchaseReturn) SetPayedSum(payedSum Decimal) *PurchaseReturn {
 	purchaseReturn.PayedSum = &payedSum
 	return purchaseReturn
 }
@@ -532,13 +535,15 @@ type PurchaseReturnPosition struct {
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Slot       *Slot               `json:"slot,omitempty"`       // Ячейка на складе
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	Things     Slice[string]       `json:"things,omitempty"`     // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -569,7 +574,7 @@ func (purchaseReturnPosition PurchaseReturnPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (purchaseReturnPosition PurchaseReturnPosition) GetPrice() float64 {
+func (purchaseReturnPosition PurchaseReturnPosition) GetPrice() Decimal {
 	return Deref(purchaseReturnPosition.Price)
 }
 
@@ -577,7 +582,7 @@ func (purchaseReturnPosition PurchaseReturnPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (purchaseReturnPosition PurchaseReturnPosition) GetQuantity() float64 {
+func (purchaseReturnPosition PurchaseReturnPosition) GetQuantity() Decimal {
 	return Deref(purchaseReturnPosition.Quantity)
 }
 
@@ -640,7 +645,7 @@ func (purchaseReturnPosition *PurchaseReturnPosition) SetPack(pack *Pack) *Purch
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (purchaseReturnPosition *PurchaseReturnPosition) SetPrice(price float64) *PurchaseReturnPosition {
+func (purchaseReturnPosition *PurchaseReturnPosition) SetPrice(price Decimal) *PurchaseReturnPosition {
 	purchaseReturnPosition.Price = &price
 	return purchaseReturnPosition
 }
@@ -649,7 +654,7 @@ func (purchaseReturnPosition *PurchaseReturnPosition) SetPrice(price float64) *P
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (purchaseReturnPosition *PurchaseReturnPosition) SetQuantity(quantity float64) *PurchaseReturnPosition {
+func (purchaseReturnPosition *PurchaseReturnPosition) SetQuantity(quantity Decimal) *PurchaseReturnPosition {
 	purchaseReturnPosition.Quantity = &quantity
 	return purchaseReturnPosition
 }
@@ -707,6 +712,11 @@ type PurchaseReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[PurchaseReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех возвратов поставщику постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*PurchaseReturn, error]
+
 	// Create выполняет запрос на создание возврата поставщику.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -771,6 +781,11 @@ type PurchaseReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[PurchaseReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*PurchaseReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
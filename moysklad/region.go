@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -23,6 +24,8 @@ type Region struct {
 	Name         *string    `json:"name,omitempty"`         // Наименование Региона
 	Updated      *Timestamp `json:"updated,omitempty"`      // Момент последнего обновления Региона
 	Version      *int       `json:"version,omitempty"`      // Версия сущности
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -121,6 +124,11 @@ type RegionService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Region], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех регионов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Region, error]
+
 	// GetByID выполняет запрос на получение отдельного региона по ID.
 	// Принимает контекст, ID региона и опционально объект параметров запроса Params.
 	// Возвращает найденный регион.
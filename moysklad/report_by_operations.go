@@ -16,9 +16,9 @@ type ReportByOperationsStock struct {
 	Store        MetaWrapper `json:"store"`        // Метаданные склада документа
 	Moment       Timestamp   `json:"moment"`       // Дата документа
 	AvgStockDays float64     `json:"avgStockDays"` // Количество дней на складе
-	CostPerUnit  float64     `json:"costPerUnit"`  // Себестоимость за единицу
-	Stock        float64     `json:"stock"`        // Остатки
-	SumCost      float64     `json:"sumCost"`      // Сумма себестоимости
+	CostPerUnit  Decimal     `json:"costPerUnit"`  // Себестоимость за единицу
+	Stock        Decimal     `json:"stock"`        // Остатки
+	SumCost      Decimal     `json:"sumCost"`      // Сумма себестоимости
 }
 
 // ReportByOperationsReserve Отчет с резервами.
@@ -31,7 +31,7 @@ type ReportByOperationsReserve struct {
 	Operation  MetaWrapper `json:"operation"`  // Метаданные документа
 	Store      MetaWrapper `json:"store"`      // Метаданные склада документа
 	Moment     Timestamp   `json:"moment"`     // Дата документа
-	Reserve    float64     `json:"reserve"`    // Резерв
+	Reserve    Decimal     `json:"reserve"`    // Резерв
 }
 
 // ReportByOperationsTransit Отчет с ожиданием.
@@ -44,7 +44,7 @@ type ReportByOperationsTransit struct {
 	Operation  MetaWrapper `json:"operation"`  // Метаданные документа
 	Store      MetaWrapper `json:"store"`      // Метаданные склада документа
 	Moment     Timestamp   `json:"moment"`     // Дата документа
-	InTransit  float64     `json:"inTransit"`  // Ожидания
+	InTransit  Decimal     `json:"inTransit"`  // Ожидания
 }
 
 // ReportByOperationsService описывает методы сервиса для работы с отчётом по документам номенклатуры.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // ReportCounterparty Показатели контрагентов.
@@ -21,13 +22,13 @@ type ReportCounterparty struct {
 	Counterparty    ReportCounterpartyInfo `json:"counterparty"`    // Контрагент
 	Meta            Meta                   `json:"meta"`            // Метаданные Отчета по данному контрагенту
 	LastEventText   string                 `json:"lastEventText"`   // Текст последнего события
-	DemandsSum      float64                `json:"demandsSum"`      // Сумма продаж
-	DiscountsSum    float64                `json:"discountsSum"`    // Сумма скидок
-	AverageReceipt  float64                `json:"averageReceipt"`  // Средний чек
+	DemandsSum      Decimal                `json:"demandsSum"`      // Сумма продаж
+	DiscountsSum    Decimal                `json:"discountsSum"`    // Сумма скидок
+	AverageReceipt  Decimal                `json:"averageReceipt"`  // Средний чек
 	BonusBalance    float64                `json:"bonusBalance"`    // Баллы
-	Profit          float64                `json:"profit"`          // Прибыль
-	ReturnsSum      float64                `json:"returnsSum"`      // Сумма возвратов
-	Balance         float64                `json:"balance"`         // Баланс
+	Profit          Decimal                `json:"profit"`          // Прибыль
+	ReturnsSum      Decimal                `json:"returnsSum"`      // Сумма возвратов
+	Balance         Decimal                `json:"balance"`         // Баланс
 	DemandsCount    int                    `json:"demandsCount"`    // Количество продаж
 	ReturnsCount    int                    `json:"returnsCount"`    // Количество возвратов
 }
@@ -66,6 +67,11 @@ type ReportCounterpartyService interface {
 	// Возвращает объект List.
 	GetList(ctx context.Context, params ...func(*Params)) (*List[ReportCounterparty], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения строк отчёта по контрагентам постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ReportCounterparty, error]
+
 	// GetListAsync выполняет запрос на получение отчёта по контрагентам (асинхронно).
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает сервис для работы с контекстом асинхронного запроса.
@@ -94,6 +100,10 @@ func (service *reportCounterpartyService) GetList(ctx context.Context, params ..
 	return NewRequestBuilder[List[ReportCounterparty]](service.client, service.uri).SetParams(params).Get(ctx)
 }
 
+func (service *reportCounterpartyService) GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ReportCounterparty, error] {
+	return listSeq[ReportCounterparty](ctx, service.client, service.uri, params)
+}
+
 func (service *reportCounterpartyService) GetListAsync(ctx context.Context) (AsyncResultService[List[ReportCounterparty]], *resty.Response, error) {
 	return NewRequestBuilder[List[ReportCounterparty]](service.client, service.uri).Async(ctx)
 }
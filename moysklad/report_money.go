@@ -15,7 +15,7 @@ import (
 type Money struct {
 	Account      MetaNameWrapper `json:"account"`      // Счет организации (не выводится для остатка кассы, так как касса одна на организацию)
 	Organization MetaNameWrapper `json:"organization"` // Организация
-	Balance      float64         `json:"balance"`      // Текущий остаток денежных средств
+	Balance      Decimal         `json:"balance"`      // Текущий остаток денежных средств
 }
 
 // MetaType возвращает код сущности.
@@ -34,8 +34,8 @@ type MoneyPlotSeries struct {
 	Context Context             `json:"context"` // Метаданные о выполнившем запрос сотруднике
 	Meta    Meta                `json:"meta"`    // Метаданные запроса
 	Series  []PlotSeriesElement `json:"series"`  // Массив показателей
-	Credit  float64             `json:"credit"`  // Доход
-	Debit   float64             `json:"debit"`   // Расход
+	Credit  Decimal             `json:"credit"`  // Доход
+	Debit   Decimal             `json:"debit"`   // Расход
 }
 
 // MetaType возвращает код сущности.
@@ -50,9 +50,9 @@ func (MoneyPlotSeries) MetaType() MetaType {
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-otchet-den-gi-dwizhenie-denezhnyh-sredstw-pokazateli-series
 type PlotSeriesElement struct {
 	Date    string  `json:"date"`    // Дата
-	Credit  float64 `json:"credit"`  // Доход за период
-	Debit   float64 `json:"debit"`   // Расход за период
-	Balance float64 `json:"balance"` // Баланс (доход-расход)
+	Credit  Decimal `json:"credit"`  // Доход за период
+	Debit   Decimal `json:"debit"`   // Расход за период
+	Balance Decimal `json:"balance"` // Баланс (доход-расход)
 }
 
 // ReportMoneyService описывает методы сервиса для работы с отчётом Деньги.
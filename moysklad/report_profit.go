@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // ProfitByAssortment Прибыльность по товарам
@@ -12,19 +13,19 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-otchet-pribyl-nost-poluchit-pribyl-nost-po-towaram
 type ProfitByAssortment struct {
 	Assortment     ReportProfitAssortment `json:"assortment"`     // Краткое представление Модификации, Услуги или Комплекта в отчете
-	SellCostSum    float64                `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
-	Profit         float64                `json:"profit"`         // Прибыль
-	ReturnCost     float64                `json:"returnCost"`     // Себестоимость возвратов в копейках
-	ReturnCostSum  float64                `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
-	ReturnPrice    float64                `json:"returnPrice"`    // Цена возвратов
-	ReturnSum      float64                `json:"returnSum"`      // Сумма возвратов
-	SellCost       float64                `json:"sellCost"`       // Себестоимость в копейках
+	SellCostSum    Decimal                `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
+	Profit         Decimal                `json:"profit"`         // Прибыль
+	ReturnCost     Decimal                `json:"returnCost"`     // Себестоимость возвратов в копейках
+	ReturnCostSum  Decimal                `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
+	ReturnPrice    Decimal                `json:"returnPrice"`    // Цена возвратов
+	ReturnSum      Decimal                `json:"returnSum"`      // Сумма возвратов
+	SellCost       Decimal                `json:"sellCost"`       // Себестоимость в копейках
 	Margin         float64                `json:"margin"`         // Рентабельность
 	SalesMargin    float64                `json:"salesMargin"`    // Рентабельность продаж
-	SellPrice      float64                `json:"sellPrice"`      // Цена продаж (средняя)
-	SellSum        float64                `json:"sellSum"`        // Сумма продаж
-	ReturnQuantity float64                `json:"returnQuantity"` // Возвращенное количество
-	SellQuantity   float64                `json:"sellQuantity"`   // Проданное количество
+	SellPrice      Decimal                `json:"sellPrice"`      // Цена продаж (средняя)
+	SellSum        Decimal                `json:"sellSum"`        // Сумма продаж
+	ReturnQuantity Decimal                `json:"returnQuantity"` // Возвращенное количество
+	SellQuantity   Decimal                `json:"sellQuantity"`   // Проданное количество
 }
 
 // ReportProfitAssortment Структура объекта assortment
@@ -57,13 +58,13 @@ type ReportProfitSalesChannel struct {
 type ProfitByCounterparty struct {
 	Counterparty   MetaNameWrapper `json:"counterparty"`
 	Margin         float64         `json:"margin"`         // Рентабельность
-	Profit         float64         `json:"profit"`         // Прибыль
-	ReturnAvgCheck float64         `json:"returnAvgCheck"` // Средний чек возврата
-	ReturnCostSum  float64         `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
-	ReturnSum      float64         `json:"returnSum"`      // Сумма возвратов
-	SalesAvgCheck  float64         `json:"salesAvgCheck"`  // Средний чек продаж
-	SellCostSum    float64         `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
-	SellSum        float64         `json:"sellSum"`        // Сумма продаж
+	Profit         Decimal         `json:"profit"`         // Прибыль
+	ReturnAvgCheck Decimal         `json:"returnAvgCheck"` // Средний чек возврата
+	ReturnCostSum  Decimal         `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
+	ReturnSum      Decimal         `json:"returnSum"`      // Сумма возвратов
+	SalesAvgCheck  Decimal         `json:"salesAvgCheck"`  // Средний чек продаж
+	SellCostSum    Decimal         `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
+	SellSum        Decimal         `json:"sellSum"`        // Сумма продаж
 	ReturnCount    float64         `json:"returnCount"`    // Количество возвратов
 	SalesCount     float64         `json:"salesCount"`     // Количество продаж
 	SalesMargin    float64         `json:"salesMargin"`    // Рентабельность продаж
@@ -84,13 +85,13 @@ func (ProfitByCounterparty) MetaType() MetaType {
 type ProfitByEmployee struct {
 	Employee       MetaNameWrapper `json:"employee"`       // Краткое представление Сотрудника в отчете
 	Margin         float64         `json:"margin"`         // Рентабельность
-	Profit         float64         `json:"profit"`         // Прибыль
-	ReturnAvgCheck float64         `json:"returnAvgCheck"` // Средний чек возврата
-	ReturnCostSum  float64         `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
-	ReturnSum      float64         `json:"returnSum"`      // Сумма возвратов
-	SalesAvgCheck  float64         `json:"salesAvgCheck"`  // Средний чек продаж
-	SellCostSum    float64         `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
-	SellSum        float64         `json:"sellSum"`        // Сумма продаж
+	Profit         Decimal         `json:"profit"`         // Прибыль
+	ReturnAvgCheck Decimal         `json:"returnAvgCheck"` // Средний чек возврата
+	ReturnCostSum  Decimal         `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
+	ReturnSum      Decimal         `json:"returnSum"`      // Сумма возвратов
+	SalesAvgCheck  Decimal         `json:"salesAvgCheck"`  // Средний чек продаж
+	SellCostSum    Decimal         `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
+	SellSum        Decimal         `json:"sellSum"`        // Сумма продаж
 	ReturnCount    float64         `json:"returnCount"`    // Количество возвратов
 	SalesCount     float64         `json:"salesCount"`     // Количество продаж
 	SalesMargin    float64         `json:"salesMargin"`    // Рентабельность продаж
@@ -143,13 +144,13 @@ func (ProfitByVariant) MetaType() MetaType {
 type ProfitBySalesChannel struct {
 	SalesChannel   ReportProfitSalesChannel `json:"salesChannel"`   // Краткое представление Канала продаж в отчете
 	Margin         float64                  `json:"margin"`         // Рентабельность
-	Profit         float64                  `json:"profit"`         // Прибыль
-	ReturnAvgCheck float64                  `json:"returnAvgCheck"` // Средний чек возврата
-	ReturnCostSum  float64                  `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
-	ReturnSum      float64                  `json:"returnSum"`      // Сумма возвратов
-	SalesAvgCheck  float64                  `json:"salesAvgCheck"`  // Средний чек продаж
-	SellCostSum    float64                  `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
-	SellSum        float64                  `json:"sellSum"`        // Сумма продаж
+	Profit         Decimal                  `json:"profit"`         // Прибыль
+	ReturnAvgCheck Decimal                  `json:"returnAvgCheck"` // Средний чек возврата
+	ReturnCostSum  Decimal                  `json:"returnCostSum"`  // Сумма себестоимостей возвратов в копейках
+	ReturnSum      Decimal                  `json:"returnSum"`      // Сумма возвратов
+	SalesAvgCheck  Decimal                  `json:"salesAvgCheck"`  // Средний чек продаж
+	SellCostSum    Decimal                  `json:"sellCostSum"`    // Сумма себестоимостей продаж в копейках
+	SellSum        Decimal                  `json:"sellSum"`        // Сумма продаж
 	ReturnCount    float64                  `json:"returnCount"`    // Количество возвратов
 	SalesCount     float64                  `json:"salesCount"`     // Количество продаж
 	SalesMargin    float64                  `json:"salesMargin"`    // Рентабельность продаж
@@ -167,26 +168,51 @@ type ReportProfitService interface {
 	// Возвращает объект List.
 	GetByProduct(ctx context.Context, params ...func(*Params)) (*List[ProfitByProduct], *resty.Response, error)
 
+	// GetByProductSeq возвращает итератор для получения отчёта "Прибыльность по товарам" постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetByProductSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByProduct, error]
+
 	// GetByVariant выполняет запрос на получение отчёта "Прибыльность по модификациям".
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает объект List.
 	GetByVariant(ctx context.Context, params ...func(*Params)) (*List[ProfitByVariant], *resty.Response, error)
 
+	// GetByVariantSeq возвращает итератор для получения отчёта "Прибыльность по модификациям" постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetByVariantSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByVariant, error]
+
 	// GetByEmployee выполняет запрос на получение отчёта "Прибыльность по сотрудникам".
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает объект List.
 	GetByEmployee(ctx context.Context, params ...func(*Params)) (*List[ProfitByEmployee], *resty.Response, error)
 
+	// GetByEmployeeSeq возвращает итератор для получения отчёта "Прибыльность по сотрудникам" постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetByEmployeeSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByEmployee, error]
+
 	// GetByCounterparty выполняет запрос на получение отчёта "Прибыльность по покупателям".
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает объект List.
 	GetByCounterparty(ctx context.Context, params ...func(*Params)) (*List[ProfitByCounterparty], *resty.Response, error)
 
+	// GetByCounterpartySeq возвращает итератор для получения отчёта "Прибыльность по покупателям" постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetByCounterpartySeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByCounterparty, error]
+
 	// GetBySalesChannel выполняет запрос на получение отчёта "Прибыльность по каналам продаж".
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает объект List.
 	GetBySalesChannel(ctx context.Context, params ...func(*Params)) (*List[ProfitBySalesChannel], *resty.Response, error)
 
+	// GetBySalesChannelSeq возвращает итератор для получения отчёта "Прибыльность по каналам продаж" постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetBySalesChannelSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitBySalesChannel, error]
+
 	// GetByProductAsync выполняет запрос на получение отчёта "Прибыльность по товарам" (асинхронно).
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает сервис для работы с контекстом асинхронного запроса.
@@ -230,22 +256,42 @@ func (service *reportProfitService) GetByProduct(ctx context.Context, params ...
 	return NewRequestBuilder[List[ProfitByProduct]](service.client, EndpointReportProfitByProduct).SetParams(params).Get(ctx)
 }
 
+func (service *reportProfitService) GetByProductSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByProduct, error] {
+	return listSeq[ProfitByProduct](ctx, service.client, EndpointReportProfitByProduct, params)
+}
+
 func (service *reportProfitService) GetByVariant(ctx context.Context, params ...func(*Params)) (*List[ProfitByVariant], *resty.Response, error) {
 	return NewRequestBuilder[List[ProfitByVariant]](service.client, EndpointReportProfitByVariant).SetParams(params).Get(ctx)
 }
 
+func (service *reportProfitService) GetByVariantSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByVariant, error] {
+	return listSeq[ProfitByVariant](ctx, service.client, EndpointReportProfitByVariant, params)
+}
+
 func (service *reportProfitService) GetByEmployee(ctx context.Context, params ...func(*Params)) (*List[ProfitByEmployee], *resty.Response, error) {
 	return NewRequestBuilder[List[ProfitByEmployee]](service.client, EndpointReportProfitByEmployee).SetParams(params).Get(ctx)
 }
 
+func (service *reportProfitService) GetByEmployeeSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByEmployee, error] {
+	return listSeq[ProfitByEmployee](ctx, service.client, EndpointReportProfitByEmployee, params)
+}
+
 func (service *reportProfitService) GetByCounterparty(ctx context.Context, params ...func(*Params)) (*List[ProfitByCounterparty], *resty.Response, error) {
 	return NewRequestBuilder[List[ProfitByCounterparty]](service.client, EndpointReportProfitByCounterparty).SetParams(params).Get(ctx)
 }
 
+func (service *reportProfitService) GetByCounterpartySeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitByCounterparty, error] {
+	return listSeq[ProfitByCounterparty](ctx, service.client, EndpointReportProfitByCounterparty, params)
+}
+
 func (service *reportProfitService) GetBySalesChannel(ctx context.Context, params ...func(*Params)) (*List[ProfitBySalesChannel], *resty.Response, error) {
 	return NewRequestBuilder[List[ProfitBySalesChannel]](service.client, EndpointReportProfitBySalesChannel).SetParams(params).Get(ctx)
 }
 
+func (service *reportProfitService) GetBySalesChannelSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*ProfitBySalesChannel, error] {
+	return listSeq[ProfitBySalesChannel](ctx, service.client, EndpointReportProfitBySalesChannel, params)
+}
+
 func (service *reportProfitService) GetByProductAsync(ctx context.Context, params ...func(*Params)) (AsyncResultService[List[ProfitByProduct]], *resty.Response, error) {
 	return NewRequestBuilder[List[ProfitByProduct]](service.client, EndpointReportProfitByProduct).SetParams(params).Async(ctx)
 }
@@ -12,8 +12,8 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-pokazateli-prodazh-i-zakazow-pokazateli-series
 type SeriesElement struct {
 	Date     Timestamp `json:"date"`     // Дата
-	Sum      float64   `json:"sum"`      // Количество
-	Quantity float64   `json:"quantity"` // Сумма
+	Sum      Decimal   `json:"sum"`      // Количество
+	Quantity Decimal   `json:"quantity"` // Сумма
 }
 
 // SalesPlotSeries Показатели продаж.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // StockAll Расширенный отчёт об остатках.
@@ -22,12 +23,12 @@ type StockAll struct {
 	ExternalCode string          `json:"externalCode"` // Внешний код сущности, по которой выводится остаток
 	Code         string          `json:"code"`         // Код
 	Name         string          `json:"name"`         // Наименование
-	InTransit    float64         `json:"inTransit"`    // Ожидание
-	Price        float64         `json:"price"`        // Себестоимость в копейках
-	Quantity     float64         `json:"quantity"`     // Доступно
-	Reserve      float64         `json:"reserve"`      // Резерв
-	SalePrice    float64         `json:"salePrice"`    // Цена продажи
-	Stock        float64         `json:"stock"`        // Остаток
+	InTransit    Decimal         `json:"inTransit"`    // Ожидание
+	Price        Decimal         `json:"price"`        // Себестоимость в копейках
+	Quantity     Decimal         `json:"quantity"`     // Доступно
+	Reserve      Decimal         `json:"reserve"`      // Резерв
+	SalePrice    Decimal         `json:"salePrice"`    // Цена продажи
+	Stock        Decimal         `json:"stock"`        // Остаток
 	StockDays    float64         `json:"stockDays"`    // Количество дней на складе
 }
 
@@ -61,11 +62,11 @@ func (StockByOperation) MetaType() MetaType {
 type StockByOperationPosition struct {
 	Meta      Meta    `json:"meta"`      // Метаданные склада, по которому выводится Остаток
 	Name      string  `json:"name"`      // Наименование склада
-	Stock     float64 `json:"stock"`     // Остаток
-	Cost      float64 `json:"cost"`      // Себестоимость
-	InTransit float64 `json:"inTransit"` // Ожидание
-	Reserve   float64 `json:"reserve"`   // Резерв
-	Quantity  float64 `json:"quantity"`  // Доступно
+	Stock     Decimal `json:"stock"`     // Остаток
+	Cost      Decimal `json:"cost"`      // Себестоимость
+	InTransit Decimal `json:"inTransit"` // Ожидание
+	Reserve   Decimal `json:"reserve"`   // Резерв
+	Quantity  Decimal `json:"quantity"`  // Доступно
 }
 
 // StockByStore Остатки по складам.
@@ -93,9 +94,9 @@ func (StockByStore) MetaType() MetaType {
 type StockByStorePosition struct {
 	Meta      Meta    `json:"meta"`      // Метаданные склада, по которому выводится Остаток
 	Name      string  `json:"name"`      // Наименование склада
-	Stock     float64 `json:"stock"`     // Остаток
-	InTransit float64 `json:"inTransit"` // Ожидание
-	Reserve   float64 `json:"reserve"`   // Резерв
+	Stock     Decimal `json:"stock"`     // Остаток
+	InTransit Decimal `json:"inTransit"` // Ожидание
+	Reserve   Decimal `json:"reserve"`   // Резерв
 }
 
 // StockCurrentAll Краткий отчёт об остатках
@@ -105,11 +106,11 @@ type StockByStorePosition struct {
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-otchet-ostatki-poluchit-kratkij-otchet-ob-ostatkah
 type StockCurrentAll struct {
 	AssortmentID string  `json:"assortmentId"` // ID Товара/Модификации/Серии
-	Stock        float64 `json:"stock"`        // Физический остаток на складах, без учёта резерва и ожидания
-	FreeStock    float64 `json:"freeStock"`    // Остаток на складах за вычетом резерва
-	Quantity     float64 `json:"quantity"`     // Доступно. Учитывает резерв и ожидания
-	Reserve      float64 `json:"reserve"`      // Резерв
-	InTransit    float64 `json:"inTransit"`    // Ожидание
+	Stock        Decimal `json:"stock"`        // Физический остаток на складах, без учёта резерва и ожидания
+	FreeStock    Decimal `json:"freeStock"`    // Остаток на складах за вычетом резерва
+	Quantity     Decimal `json:"quantity"`     // Доступно. Учитывает резерв и ожидания
+	Reserve      Decimal `json:"reserve"`      // Резерв
+	InTransit    Decimal `json:"inTransit"`    // Ожидание
 }
 
 // StockCurrentByStore Краткий отчёт об остатках
@@ -120,11 +121,11 @@ type StockCurrentAll struct {
 type StockCurrentByStore struct {
 	AssortmentID string  `json:"assortmentId"` // Выдать в отчёте только указанные товары, модификации и серии
 	StoreID      string  `json:"storeId"`      // ID склада
-	Stock        float64 `json:"stock"`        // Физический остаток на складах, без учёта резерва и ожидания
-	FreeStock    float64 `json:"freeStock"`    // Остаток на складах за вычетом резерва
-	Quantity     float64 `json:"quantity"`     // Доступно. Учитывает резерв и ожидания
-	Reserve      float64 `json:"reserve"`      // Резерв
-	InTransit    float64 `json:"inTransit"`    // Ожидание
+	Stock        Decimal `json:"stock"`        // Физический остаток на складах, без учёта резерва и ожидания
+	FreeStock    Decimal `json:"freeStock"`    // Остаток на складах за вычетом резерва
+	Quantity     Decimal `json:"quantity"`     // Доступно. Учитывает резерв и ожидания
+	Reserve      Decimal `json:"reserve"`      // Резерв
+	InTransit    Decimal `json:"inTransit"`    // Ожидание
 }
 
 // StockFolder Группа
@@ -145,11 +146,21 @@ type ReportStockService interface {
 	// Возвращает объект List.
 	GetAll(ctx context.Context, params ...func(*Params)) (*List[StockAll], *resty.Response, error)
 
+	// GetAllSeq возвращает итератор для получения Расширенного отчёта об остатках постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetAllSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*StockAll, error]
+
 	// GetByStore выполняет запрос на получение отчёта "Остатки по складам".
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает объект List.
 	GetByStore(ctx context.Context, params ...func(*Params)) (*List[StockByStore], *resty.Response, error)
 
+	// GetByStoreSeq возвращает итератор для получения отчёта "Остатки по складам" постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetByStoreSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*StockByStore, error]
+
 	// GetCurrentAll выполняет запрос на получение текущих остатков без разбиения по складам.
 	// Принимает контекст и опционально объект параметров запроса Params.
 	// Возвращает список остатков.
@@ -204,10 +215,18 @@ func (service *reportStockService) GetAll(ctx context.Context, params ...func(*P
 	return NewRequestBuilder[List[StockAll]](service.client, EndpointReportStockAll).SetParams(params).Get(ctx)
 }
 
+func (service *reportStockService) GetAllSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*StockAll, error] {
+	return listSeq[StockAll](ctx, service.client, EndpointReportStockAll, params)
+}
+
 func (service *reportStockService) GetByStore(ctx context.Context, params ...func(*Params)) (*List[StockByStore], *resty.Response, error) {
 	return NewRequestBuilder[List[StockByStore]](service.client, EndpointReportStockByStore).SetParams(params).Get(ctx)
 }
 
+func (service *reportStockService) GetByStoreSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*StockByStore, error] {
+	return listSeq[StockByStore](ctx, service.client, EndpointReportStockByStore, params)
+}
+
 func (service *reportStockService) GetCurrentAll(ctx context.Context, params ...func(*Params)) (*Slice[StockCurrentAll], *resty.Response, error) {
 	return NewRequestBuilder[Slice[StockCurrentAll]](service.client, EndpointReportStockAllCurrent).SetParams(params).Get(ctx)
 }
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // TurnoverIncomeOutcome Структура объекта показатели (onPeriodStart, onPeriodEnd, income, outcome).
@@ -11,8 +12,8 @@ import (
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/reports/#otchety-otchet-oboroty-oboroty-po-towaram-struktura-ob-ekta-pokazateli-onperiodstart-onperiodend-income-outcome
 type TurnoverIncomeOutcome struct {
-	Sum      float64 `json:"sum"`      // Сумма себестоимости
-	Quantity float64 `json:"quantity"` // Количество единиц товара
+	Sum      Decimal `json:"sum"`      // Сумма себестоимости
+	Quantity Decimal `json:"quantity"` // Количество единиц товара
 }
 
 // TurnoverAll Обороты по товарам.
@@ -52,9 +53,9 @@ type TurnoverByOperation struct {
 	Assortment TurnoverAssortment `json:"assortment"` // Краткое представление Товара или Модификации в отчете
 	Operation  TurnoverOperation  `json:"operation"`  // Документ, связанный с Товаром
 	Store      MetaNameWrapper    `json:"store"`      // Склад
-	Cost       float64            `json:"cost"`       // Себестоимость товара в копейках в документе
-	Sum        float64            `json:"sum"`        // Сумма себестоимостей в копейках
-	Quantity   float64            `json:"quantity"`   // Количество товара в документе
+	Cost       Decimal            `json:"cost"`       // Себестоимость товара в копейках в документе
+	Sum        Decimal            `json:"sum"`        // Сумма себестоимостей в копейках
+	Quantity   Decimal            `json:"quantity"`   // Количество товара в документе
 }
 
 // TurnoverOperation Структура объекта operation.
@@ -100,6 +101,11 @@ type ReportTurnoverService interface {
 	// Возвращает объект List.
 	GetAll(ctx context.Context, params ...func(*Params)) (*List[TurnoverAll], *resty.Response, error)
 
+	// GetAllSeq возвращает итератор для получения отчёта "Обороты по товарам" постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetAllSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*TurnoverAll, error]
+
 	// GetByStoreWithProduct выполняет запрос на получение отчёта обороты по товару и его модификациям с детализацией по складам.
 	// Принимает контекст и товар.
 	// Возвращает объект List.
@@ -136,6 +142,10 @@ func (service *reportTurnoverService) GetAll(ctx context.Context, params ...func
 	return NewRequestBuilder[List[TurnoverAll]](service.client, EndpointReportTurnoverAll).SetParams(params).Get(ctx)
 }
 
+func (service *reportTurnoverService) GetAllSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*TurnoverAll, error] {
+	return listSeq[TurnoverAll](ctx, service.client, EndpointReportTurnoverAll, params)
+}
+
 func (service *reportTurnoverService) GetByStoreWithProduct(ctx context.Context, product *Product) (*List[TurnoverByOperation], *resty.Response, error) {
 	return NewRequestBuilder[List[TurnoverByOperation]](service.client, EndpointReportTurnoverByStore).SetParams([]func(*Params){WithFilterObject(product)}).Get(ctx)
 }
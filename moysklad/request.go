@@ -3,11 +3,12 @@ package moysklad
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/go-resty/resty/v2"
-	"log"
+	"iter"
+	"log/slog"
 	"net/http"
 	"reflect"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -51,7 +52,7 @@ func (requestBuilder *RequestBuilder[T]) SetHeader(header, value string) *Reques
 }
 
 func (requestBuilder *RequestBuilder[T]) SetParams(params []func(*Params)) *RequestBuilder[T] {
-	values := ApplyParams(params).Values()
+	values := applyParams(params, requestBuilder.client.location).Values()
 
 	requestBuilder.req.SetQueryParamsFromValues(values)
 
@@ -59,19 +60,152 @@ func (requestBuilder *RequestBuilder[T]) SetParams(params []func(*Params)) *Requ
 }
 
 func (requestBuilder *RequestBuilder[T]) Send(ctx context.Context, method string, body any) (*T, *resty.Response, error) {
-	// Ограничения на количество запросов
-	requestBuilder.client.limits.Wait()
-	defer requestBuilder.client.limits.Done()
-	requestBuilder.waitLimits()
-
-	resp, err := requestBuilder.req.SetContext(ctx).SetBody(body).Execute(method, requestBuilder.uri)
-	requestBuilder.parseLimits(resp)
+	// дата и время в теле запроса передаются в часовом поясе учётной записи: в часовом поясе по умолчанию
+	// их переводит Timestamp.MarshalJSON, для другого часового пояса тело копируется,
+	// чтобы не изменять объект вызывающего кода
+	if location := requestBuilder.client.location; body != nil && location != DefaultLocation {
+		body = timestampsFrom(reflect.ValueOf(body), location).Interface()
+	}
 
+	resp, err := requestBuilder.execute(ctx, method, body)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return parseResponse[T](resp)
+	result, resp, err := parseResponse[T](requestBuilder.client.logger, resp)
+	if err != nil {
+		return result, resp, err
+	}
+
+	if requestBuilder.client.onSchemaDrift != nil {
+		requestBuilder.client.detectSchemaDrift(requestBuilder.uri, reflect.TypeFor[T](), resp.Body())
+	}
+
+	if requestBuilder.client.preserveUnknownFields {
+		keepUnknownFields(reflect.ValueOf(result))
+	}
+
+	if location := requestBuilder.client.location; location != DefaultLocation {
+		walkTimestamps(reflect.ValueOf(result), func(timestamp *Timestamp) {
+			*timestamp = timestamp.inLocation(location)
+		})
+	}
+	return result, resp, nil
+}
+
+// execute выполняет запрос через цепочку [Middleware] клиента.
+func (requestBuilder *RequestBuilder[T]) execute(ctx context.Context, method string, body any) (*resty.Response, error) {
+	info := &RequestInfo{
+		Request:    requestBuilder.req,
+		Params:     requestBuilder.req.QueryParam,
+		Body:       body,
+		Method:     method,
+		URI:        requestBuilder.uri,
+		ResultType: reflect.TypeFor[T]().String(),
+	}
+
+	handler := requestBuilder.retry
+	if requestBuilder.client.flights != nil {
+		handler = requestBuilder.client.flights.handler(handler)
+	}
+	if requestBuilder.client.cache != nil {
+		handler = requestBuilder.client.cache.handler(handler)
+	}
+	handler = requestBuilder.client.dryRunHandler(handler)
+
+	return requestBuilder.client.chain(handler)(ctx, info)
+}
+
+// retry выполняет запрос с учётом политики повторов [RetryPolicy].
+//
+// Количество выполненных попыток доступно в поле Attempt объекта [resty.Request] ответа.
+func (requestBuilder *RequestBuilder[T]) retry(ctx context.Context, info *RequestInfo) (*resty.Response, error) {
+	policy := requestBuilder.client.retryPolicy
+
+	var reauthorized bool
+
+	for attempt := 1; ; attempt++ {
+		resp, err := requestBuilder.do(ctx, info, attempt)
+
+		// повторная попытка с новым токеном после ответа 401 не расходует попытку политики повторов
+		if !reauthorized && requestBuilder.client.invalidateToken(info.Request, resp) {
+			reauthorized = true
+			attempt--
+			continue
+		}
+
+		if !policy.shouldRetry(ctx, info.Method, attempt, resp, err) {
+			if resp != nil && resp.Request != nil {
+				resp.Request.Attempt = attempt
+			}
+			return resp, err
+		}
+
+		if err := policy.wait(ctx, attempt, resp); err != nil {
+			return resp, err
+		}
+
+		requestBuilder.client.metrics.IncRetry(entityFromURI(info.URI), info.Method)
+	}
+}
+
+// do выполняет одну попытку запроса.
+func (requestBuilder *RequestBuilder[T]) do(ctx context.Context, info *RequestInfo, attempt int) (resp *resty.Response, err error) {
+	ctx, span := requestBuilder.client.startRequestSpan(ctx, info, attempt)
+	defer func() { endRequestSpan(span, resp, err) }()
+
+	metrics := requestBuilder.client.metrics
+
+	// токен запрашивается до ограничителя, так как его получение само является запросом
+	if err = requestBuilder.client.authorize(ctx, info.Request); err != nil {
+		return nil, err
+	}
+
+	// при разомкнутом автомате запрос отклоняется до ожидания ограничителя
+	var sent bool
+	if breaker := requestBuilder.client.breaker; breaker != nil {
+		done, openErr := breaker.allow()
+		if openErr != nil {
+			return nil, openErr
+		}
+		defer func() {
+			// истечение срока контекста при ожидании ограничителя не является ошибкой API
+			if !sent {
+				done(0)
+				return
+			}
+			done(circuitOutcome(ctx, resp, err))
+		}()
+	}
+
+	// Ограничения на количество запросов
+	limiter := requestBuilder.client.limiter
+	waitStart := time.Now()
+	if err = limiter.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	sent = true
+	defer limiter.Release()
+
+	metrics.ObserveLimiterWait(time.Since(waitStart))
+	span.AddEvent("limiter acquired")
+
+	metrics.AddInFlight(1)
+	defer metrics.AddInFlight(-1)
+
+	info.Request.QueryParam = info.Params
+
+	start := time.Now()
+	resp, err = info.Request.SetContext(ctx).SetBody(info.Body).Execute(info.Method, info.URI)
+	if resp != nil {
+		limiter.Observe(resp.Header())
+	}
+
+	duration := time.Since(start)
+	observeResponse(metrics, info, resp, duration)
+	requestBuilder.client.logExchange(ctx, info, resp, duration)
+
+	return resp, err
 }
 
 func (requestBuilder *RequestBuilder[T]) Get(ctx context.Context) (*T, *resty.Response, error) {
@@ -88,20 +222,17 @@ func (requestBuilder *RequestBuilder[T]) Post(ctx context.Context, body any) (*T
 
 func (requestBuilder *RequestBuilder[T]) Delete(ctx context.Context) (bool, *resty.Response, error) {
 	_, resp, err := requestBuilder.Send(ctx, http.MethodDelete, nil)
+	if resp == nil {
+		return false, resp, err
+	}
 	return resp.StatusCode() == http.StatusOK || resp.StatusCode() == http.StatusNoContent, resp, err
 }
 
 func (requestBuilder *RequestBuilder[T]) Async(ctx context.Context) (AsyncResultService[T], *resty.Response, error) {
-	// Ограничения на количество запросов
-	requestBuilder.client.limits.Wait()
-	defer requestBuilder.client.limits.Done()
-	requestBuilder.waitLimits()
-
 	// устанавливаем флаг async=true на создание асинхронной операции
-	requestBuilder.req.SetContext(ctx).SetQueryParam("async", "true")
+	requestBuilder.req.SetQueryParam("async", "true")
 
-	resp, err := requestBuilder.req.Get(requestBuilder.uri)
-	requestBuilder.parseLimits(resp)
+	resp, err := requestBuilder.execute(ctx, http.MethodGet, nil)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -115,11 +246,32 @@ func (requestBuilder *RequestBuilder[T]) Async(ctx context.Context) (AsyncResult
 //
 // Необходимо точно указать обобщённый тип T, который ожидаем получить в ответ, иначе есть риск получить ошибку.
 func FetchMeta[T any](ctx context.Context, client *Client, meta Meta, params ...func(*Params)) (*T, *resty.Response, error) {
-	return NewRequestBuilder[T](client, strings.ReplaceAll(meta.GetHref(), baseApiURL, "")).SetParams(params).Get(ctx)
+	return NewRequestBuilder[T](client, client.hrefPath(meta.GetHref())).SetParams(params).Get(ctx)
+}
+
+// hrefPath возвращает путь ссылки href относительно базового адреса клиента.
+//
+// Ссылки на адрес API по умолчанию и ссылки с путём JSON API на другом адресе также приводятся к пути,
+// так как прокси и зеркала могут возвращать ссылки, не совпадающие с базовым адресом клиента.
+// Остальные ссылки возвращаются без изменений и запрашиваются как абсолютные адреса.
+func (client *Client) hrefPath(href string) string {
+	for _, base := range []string{strings.TrimSuffix(client.BaseURL, "/") + "/", baseApiURL} {
+		if path, ok := strings.CutPrefix(href, base); ok {
+			return path
+		}
+	}
+
+	if _, rest, ok := strings.Cut(href, "://"); ok {
+		if _, path, ok := strings.Cut(rest, remapPathPrefix); ok {
+			return path
+		}
+	}
+
+	return href
 }
 
 // TODO: improve
-func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
+func parseResponse[T any](logger *slog.Logger, r *resty.Response) (*T, *resty.Response, error) {
 	// check empty response body
 	if r.Body() == nil {
 		return nil, r, nil
@@ -160,7 +312,8 @@ func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 			}
 
 			if resultType.Kind() != reflect.Struct {
-				log.Printf("[DEBUG] resultType is not a struct: %v", resultType.Kind())
+				logger.Debug("moysklad: result type is not a struct",
+					slog.String("uri", responseURL(r)), slog.String("kind", resultType.Kind().String()))
 				return nil, r, nil
 			}
 
@@ -174,7 +327,8 @@ func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 			if dataType.Kind() == reflect.Slice {
 				dataType = dataType.Elem()
 			} else {
-				log.Printf("[DEBUG] dataType is %v", dataType.Kind())
+				logger.Debug("moysklad: result type is not a slice",
+					slog.String("uri", responseURL(r)), slog.String("kind", dataType.Kind().String()))
 				return nil, r, nil
 			}
 
@@ -230,178 +384,307 @@ func parseResponse[T any](r *resty.Response) (*T, *resty.Response, error) {
 			}
 
 		case statusCode >= http.StatusBadRequest: // error
-			if err := json.Unmarshal(bodyBytes, &apiErrors); err != nil {
-				return nil, r, err
-			}
+			// тело ответа может не содержать ошибок API (например, ответ прокси-сервера),
+			// в этом случае ошибка содержит только HTTP-статус
+			_ = json.Unmarshal(bodyBytes, &apiErrors)
 		}
 	}
 
-	if len(apiErrors.ApiErrors) > 0 {
+	if len(apiErrors.ApiErrors) > 0 || r.IsError() {
+		apiErrors.setStatusCode(r.StatusCode())
 		return &result, r, apiErrors
 	}
 
 	return &result, r, nil
 }
 
-func getAll[T any](ctx context.Context, client *Client, path string, params []func(*Params)) (*Slice[T], *resty.Response, error) {
-	var offset = 1
-	var perPage = MaxPositions
-	var data Slice[T]
+// getAll выполняет запрос на получение всех объектов списка постранично.
+//
+// Первая страница запрашивается отдельно для определения размера списка, оставшиеся страницы
+// запрашиваются параллельно, но не более [MaxQueriesPerUser] одновременно.
+// Строки возвращаются в порядке смещения страниц.
+//
+// Если часть страниц получить не удалось, возвращаются строки успешно полученных страниц
+// и ошибка [*PagesError] со списком смещений неполученных страниц.
+// При указании параметра [WithFailFast] запрос прекращается при первой ошибке.
+func getAll[T any](ctx context.Context, client *Client, path string, params []func(*Params)) (_ *Slice[T], _ *resty.Response, err error) {
+	ctx, span := client.startSpan(ctx, "GetListAll "+entityFromURI(path), attrEntity.String(entityFromURI(path)))
+	defer func() { endSpan(span, err) }()
+
+	paramsCheck := ApplyParams(params)
+
+	// Если есть expand, изменяем размер страницы
+	perPage := MaxPositions
+	if len(paramsCheck.Expand) > 0 {
+		perPage = 100
+	}
+
+	pageParams := func(offset int) []func(*Params) {
+		p := make([]func(*Params), 0, len(params)+2)
+		return append(append(p, params...), WithLimit(perPage), WithOffset(offset))
+	}
 
-	_params := append(params, WithLimit(offset), WithOffset(0))
-	list, resp, err := NewRequestBuilder[List[T]](client, path).SetParams(_params).Get(ctx)
+	list, resp, err := NewRequestBuilder[List[T]](client, path).SetParams(pageParams(0)).Get(ctx)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	_paramsCheck := ApplyParams(_params)
+	size := list.Meta.Size
 
-	// Если есть expand, изменяем размер страницы
-	if len(_paramsCheck.Expand) > 0 {
-		perPage = 100
+	// страницы, начиная со второй; результаты сохраняются по индексу страницы для сохранения порядка
+	var offsets []int
+	for offset := perPage; offset < size; offset += perPage {
+		offsets = append(offsets, offset)
 	}
 
-	// Добавляем первые данные
-	data = append(data, list.Rows...)
-	size := list.Meta.Size
+	pages := make([]Slice[T], len(offsets))
+	pageErrors := make([]error, len(offsets))
+	requested := make([]bool, len(offsets))
 
-	// Канал для сбора данных из горутин
-	dataChan := make(chan Slice[T], 10)
-	defer close(dataChan)
+	pagesCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	// Обрабатываем оставшиеся страницы параллельно
-	for i := offset; i < size; i += perPage {
-		_params := append(_params[:], WithLimit(perPage), WithOffset(i))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
 
-		// Запускаем горутину для каждого запроса
-		go func(i int, _params []func(*Params)) {
-			list, _, err = NewRequestBuilder[List[T]](client, path).SetParams(_params).Get(ctx)
-			if err != nil {
-				log.Println("getAll error:", err)
-				dataChan <- nil
-				return
+	for range min(MaxQueriesPerUser, len(offsets)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				requested[i] = true
+
+				ctx, span := client.startSpan(pagesCtx, "page", attrPageOffset.Int(offsets[i]))
+				list, _, err := NewRequestBuilder[List[T]](client, path).SetParams(pageParams(offsets[i])).Get(ctx)
+				endSpan(span, err)
+
+				if err != nil {
+					pageErrors[i] = err
+					if paramsCheck.failFast {
+						cancel()
+					}
+					continue
+				}
+
+				pages[i] = list.Rows
 			}
-			dataChan <- list.Rows
-		}(i, _params)
+		}()
 	}
 
-	// Собираем результаты из канала
-	for i := offset; i < size; i += perPage {
-		rows := <-dataChan
-		if rows != nil {
-			data = append(data, rows...)
+	for i := range offsets {
+		if pagesCtx.Err() != nil {
+			break
 		}
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	data := make(Slice[T], 0, size)
+	data = append(data, list.Rows...)
+
+	var pagesErr PagesError
+	for i, offset := range offsets {
+		switch pageErr := pageErrors[i]; {
+		case !requested[i]:
+			// страница не запрашивалась после отмены
+			pagesErr.Skipped = append(pagesErr.Skipped, offset)
+		case pageErr != nil:
+			// при остановке после первой ошибки отменённые запросы не считаются ошибками страниц
+			if paramsCheck.failFast && ctx.Err() == nil && errors.Is(pageErr, context.Canceled) {
+				pagesErr.Skipped = append(pagesErr.Skipped, offset)
+				continue
+			}
+			pagesErr.Pages = append(pagesErr.Pages, &PageError{Offset: offset, Err: pageErr})
+		default:
+			data = append(data, pages[i]...)
+		}
+	}
+
+	if len(pagesErr.Pages) > 0 || len(pagesErr.Skipped) > 0 {
+		pagesErr.Total = len(offsets) + 1
+		return &data, resp, &pagesErr
 	}
 
 	return &data, resp, nil
 }
 
-func posAll[T any](ctx context.Context, client *Client, path string, entities Slice[T], params []func(*Params)) (*Slice[T], *resty.Response, error) {
+// listSeq возвращает итератор по объектам списка, который запрашивает страницы по мере обхода.
+//
+// Следующая страница запрашивается по ссылке nextHref из метаданных текущей страницы,
+// поэтому сохраняются все параметры запроса (фильтры, сортировка, expand).
+// Пока обрабатываются объекты текущей страницы, следующая запрашивается заранее.
+// При прекращении обхода запрос следующей страницы отменяется.
+// Ошибка запроса передаётся вторым значением, после чего обход завершается.
+func listSeq[T any](ctx context.Context, client *Client, path string, params []func(*Params)) iter.Seq2[*T, error] {
+	type page struct {
+		list *List[T]
+		err  error
+	}
+
+	return func(yield func(*T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		fetch := func(requestBuilder *RequestBuilder[List[T]]) <-chan page {
+			ch := make(chan page, 1)
+			go func() {
+				list, _, err := requestBuilder.Get(ctx)
+				ch <- page{list, err}
+			}()
+			return ch
+		}
+
+		next := fetch(NewRequestBuilder[List[T]](client, path).SetParams(params))
+
+		for next != nil {
+			current := <-next
+			if current.err != nil {
+				yield(nil, current.err)
+				return
+			}
+
+			next = nil
+			if nextHref := current.list.NextHref(); nextHref != "" && len(current.list.Rows) > 0 {
+				next = fetch(NewRequestBuilder[List[T]](client, client.hrefPath(nextHref)))
+			}
+
+			for _, row := range current.list.Rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// posAll выполняет массовое создание и изменение объектов порциями по [MaxPositions] объектов.
+//
+// Порции отправляются параллельно, объекты результата возвращаются в порядке порций.
+// Если часть порций выполнить не удалось, возвращаются объекты выполненных порций
+// и ошибка [*PagesError], в которой смещение – индекс первого объекта порции в entities.
+func posAll[T any](ctx context.Context, client *Client, path string, entities Slice[T], params []func(*Params)) (_ *Slice[T], _ *resty.Response, err error) {
+	ctx, span := client.startSpan(ctx, "CreateUpdateMany "+entityFromURI(path), attrEntity.String(entityFromURI(path)))
+	defer func() { endSpan(span, err) }()
+
 	if entities.Len() > MaxPositions {
-		var data Slice[T]
+		chunks := entities.IntoChunks(MaxPositions)
+		results := make([]Slice[T], len(chunks))
+		chunkErrors := make([]error, len(chunks))
+
 		var resp *resty.Response
 		var mu sync.Mutex
 		var wg sync.WaitGroup
 
-		for _, chunk := range entities.IntoChunks(MaxPositions) {
+		for i, chunk := range chunks {
 			wg.Add(1)
 
-			go func(chunk Slice[T]) {
+			go func(i int, chunk Slice[T]) {
 				defer wg.Done()
 
+				ctx, span := client.startSpan(ctx, "chunk", attrChunkIndex.Int(i), attrChunkSize.Int(chunk.Len()))
 				list, resResp, err := NewRequestBuilder[Slice[T]](client, path).SetParams(params).Post(ctx, chunk)
+				endSpan(span, err)
 
 				mu.Lock()
 				resp = resResp
 				mu.Unlock()
 
 				if err != nil {
-					log.Println("postAll error:", err)
+					client.logError(ctx, "moysklad: post chunk", err, slog.String("uri", path), slog.Int("chunk", i))
+					chunkErrors[i] = err
 					return
 				}
 
-				if list.Len() > 0 {
-					mu.Lock()
-					data.Push(list.S()...)
-					mu.Unlock()
-				}
-			}(chunk)
+				results[i] = Deref(list)
+			}(i, chunk)
 		}
 
 		wg.Wait()
 
-		return &data, resp, nil
+		var data Slice[T]
+		for _, result := range results {
+			data.Push(result.S()...)
+		}
+
+		return &data, resp, chunksError(chunkErrors, MaxPositions)
 	}
 
 	return NewRequestBuilder[Slice[T]](client, path).SetParams(params).Post(ctx, entities)
 }
 
-func deleteAll[T MetaOwner](ctx context.Context, client *Client, path string, entities Slice[T]) (*DeleteManyResponse, *resty.Response, error) {
+// deleteAll выполняет массовое удаление объектов порциями по [MaxPositions] объектов.
+//
+// Порции отправляются параллельно, результаты возвращаются в порядке порций.
+// Если часть порций выполнить не удалось, возвращаются результаты выполненных порций
+// и ошибка [*PagesError], в которой смещение – индекс первого объекта порции в entities.
+func deleteAll[T MetaOwner](ctx context.Context, client *Client, path string, entities Slice[T]) (_ *DeleteManyResponse, _ *resty.Response, err error) {
+	ctx, span := client.startSpan(ctx, "DeleteMany "+entityFromURI(path), attrEntity.String(entityFromURI(path)))
+	defer func() { endSpan(span, err) }()
+
 	if entities.Len() > MaxPositions {
-		var data DeleteManyResponse
+		chunks := entities.IntoChunks(MaxPositions)
+		results := make([]DeleteManyResponse, len(chunks))
+		chunkErrors := make([]error, len(chunks))
+
 		var resp *resty.Response
 		var mu sync.Mutex
 		var wg sync.WaitGroup
 
-		for _, chunk := range entities.IntoChunks(MaxPositions) {
+		for i, chunk := range chunks {
 			wg.Add(1)
 
-			go func(chunk Slice[T], resp *resty.Response) {
+			go func(i int, chunk Slice[T]) {
 				defer wg.Done()
 
+				ctx, span := client.startSpan(ctx, "chunk", attrChunkIndex.Int(i), attrChunkSize.Int(chunk.Len()))
 				list, resResp, err := NewRequestBuilder[DeleteManyResponse](client, path).Post(ctx, AsMetaWrapperSlice(chunk))
+				endSpan(span, err)
 
 				mu.Lock()
 				resp = resResp
 				mu.Unlock()
 
 				if err != nil {
-					log.Println("deleteAll error:", err)
+					client.logError(ctx, "moysklad: delete chunk", err, slog.String("uri", path), slog.Int("chunk", i))
+					chunkErrors[i] = err
 					return
 				}
 
-				mu.Lock()
-				data = append(data, Deref(list)...)
-				mu.Unlock()
-			}(chunk, resp)
+				results[i] = Deref(list)
+			}(i, chunk)
 		}
 
 		wg.Wait()
 
-		return &data, resp, nil
+		var data DeleteManyResponse
+		for _, result := range results {
+			data = append(data, result...)
+		}
+
+		return &data, resp, chunksError(chunkErrors, MaxPositions)
 	}
 
 	return NewRequestBuilder[DeleteManyResponse](client, path).Post(ctx, AsMetaWrapperSlice(entities))
 }
 
-func (requestBuilder *RequestBuilder[T]) parseLimits(response *resty.Response) {
-	rateLimit, err := strconv.Atoi(response.Header().Get(headerRateLimit))
-	if err != nil || rateLimit <= 0 {
-		rateLimit = 1
-	}
-
-	rateRemaining, err := strconv.Atoi(response.Header().Get(headerRateRemaining))
-	if err != nil {
-		rateRemaining = 44
+// chunksError возвращает ошибку [*PagesError] для ошибок порций по size объектов
+// или nil, если все порции выполнены.
+func chunksError(chunkErrors []error, size int) error {
+	var pagesErr PagesError
+	for i, err := range chunkErrors {
+		if err != nil {
+			pagesErr.Pages = append(pagesErr.Pages, &PageError{Offset: i * size, Err: err})
+		}
 	}
 
-	retryTimeInterval, err := strconv.Atoi(response.Header().Get(headerRetryTimeInterval))
-	if err != nil {
-		retryTimeInterval = 3000
+	if len(pagesErr.Pages) == 0 {
+		return nil
 	}
 
-	t1 := Clamp(rateRemaining/rateLimit, 1, 1000)
-	t2 := Clamp(retryTimeInterval/rateLimit, 1, 1000)
-	td := time.Duration(2 * t2 * (1/t1 - 1))
-
-	requestBuilder.client.mu.Lock()
-	defer requestBuilder.client.mu.Unlock()
-
-	requestBuilder.client.nextReqTime = time.Now().Add(time.Millisecond * td)
-}
-
-func (requestBuilder *RequestBuilder[T]) waitLimits() {
-	for time.Now().Before(requestBuilder.client.nextReqTime) {
-		time.After(50 * time.Millisecond)
-	}
+	pagesErr.Total = len(chunkErrors)
+	return &pagesErr
 }
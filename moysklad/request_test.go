@@ -0,0 +1,71 @@
+package moysklad_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+	"github.com/EnOane/go-moysklad/moysklad/moyskladtest"
+)
+
+// products возвращает count новых товаров.
+func products(count int) moysklad.Slice[moysklad.Product] {
+	var products moysklad.Slice[moysklad.Product]
+	for i := range count {
+		products.Push(new(moysklad.Product).SetName(fmt.Sprintf("Товар %d", i)))
+	}
+	return products
+}
+
+// wantChunkError проверяет, что err – [*moysklad.PagesError] с одной неудачной порцией из двух.
+func wantChunkError(t *testing.T, err error) {
+	t.Helper()
+
+	var pagesErr *moysklad.PagesError
+	if !errors.As(err, &pagesErr) {
+		t.Fatalf("error = %v, want *PagesError", err)
+	}
+	if pagesErr.Total != 2 || len(pagesErr.Pages) != 1 {
+		t.Errorf("failed %d of %d chunks, want 1 of 2", len(pagesErr.Pages), pagesErr.Total)
+	}
+	if offset := pagesErr.Pages[0].Offset; offset != 0 && offset != moysklad.MaxPositions {
+		t.Errorf("failed chunk offset = %d, want 0 or %d", offset, moysklad.MaxPositions)
+	}
+}
+
+func TestCreateUpdateManyChunkErrors(t *testing.T) {
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{})
+	ctx := context.Background()
+
+	server.FailNext(1, http.StatusInternalServerError)
+	created, _, err := client.Entity().Product().CreateUpdateMany(ctx, products(moysklad.MaxPositions+1))
+	wantChunkError(t, err)
+
+	// объекты выполненной порции возвращаются вместе с ошибкой
+	if got := server.Count(moysklad.MetaTypeProduct); created.Len() != got {
+		t.Errorf("returned %d products, server stored %d", created.Len(), got)
+	}
+}
+
+func TestDeleteManyChunkErrors(t *testing.T) {
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{})
+	ctx := context.Background()
+
+	created, _, err := client.Entity().Product().CreateUpdateMany(ctx, products(moysklad.MaxPositions+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.FailNext(1, http.StatusInternalServerError)
+	deleted, _, err := client.Entity().Product().DeleteMany(ctx, created.S()...)
+	wantChunkError(t, err)
+
+	if got := moysklad.MaxPositions + 1 - server.Count(moysklad.MetaTypeProduct); len(*deleted) != got {
+		t.Errorf("returned %d results, server deleted %d products", len(*deleted), got)
+	}
+}
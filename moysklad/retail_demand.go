@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,9 +20,9 @@ type RetailDemand struct {
 	Agent               *Agent                           `json:"agent,omitempty"`               // Метаданные контрагента
 	AgentAccount        *AgentAccount                    `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	Applicable          *bool                            `json:"applicable,omitempty"`          // Отметка о проведении
-	CashSum             *float64                         `json:"cashSum,omitempty"`             // Оплачено наличными
+	CashSum             *Decimal                         `json:"cashSum,omitempty"`             // Оплачено наличными
 	CheckNumber         *string                          `json:"checkNumber,omitempty"`         // Номер чека
-	CheckSum            *float64                         `json:"checkSum,omitempty"`            // Сумма Чека
+	CheckSum            *Decimal                         `json:"checkSum,omitempty"`            // Сумма Чека
 	Code                *string                          `json:"code,omitempty"`                // Код Розничной продажи
 	Contract            *NullValue[Contract]             `json:"contract,omitempty"`            // Метаданные договора
 	Created             *Timestamp                       `json:"created,omitempty"`             // Дата создания
@@ -38,20 +39,20 @@ type RetailDemand struct {
 	Meta                *Meta                            `json:"meta,omitempty"`                // Метаданные Розничной продажи
 	Moment              *Timestamp                       `json:"moment,omitempty"`              // Дата документа
 	Name                *string                          `json:"name,omitempty"`                // Наименование Розничной продажи
-	NoCashSum           *float64                         `json:"noCashSum,omitempty"`           // Оплачено картой
+	NoCashSum           *Decimal                         `json:"noCashSum,omitempty"`           // Оплачено картой
 	OFDCode             *string                          `json:"ofdCode,omitempty"`             // Код оператора фискальных данных
 	Organization        *Organization                    `json:"organization,omitempty"`        // Метаданные юрлица
 	OrganizationAccount *AgentAccount                    `json:"organizationAccount,omitempty"` // Метаданные счета юрлица
 	Owner               *Employee                        `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
-	PayedSum            *float64                         `json:"payedSum,omitempty"`            // Сумма входящих платежей
+	PayedSum            *Decimal                         `json:"payedSum,omitempty"`            // Сумма входящих платежей
 	Positions           *MetaArray[RetailDemandPosition] `json:"positions,omitempty"`           // Метаданные позиций Розничной продажи
-	PrepaymentCashSum   *float64                         `json:"prepaymentCashSum,omitempty"`   // Предоплата наличными
-	PrepaymentNoCashSum *float64                         `json:"prepaymentNoCashSum,omitempty"` // Предоплата картой
-	PrepaymentQRSum     *float64                         `json:"prepaymentQrSum,omitempty"`     // Предоплата по QR-коду
+	PrepaymentCashSum   *Decimal                         `json:"prepaymentCashSum,omitempty"`   // Предоплата наличными
+	PrepaymentNoCashSum *Decimal                         `json:"prepaymentNoCashSum,omitempty"` // Предоплата картой
+	PrepaymentQRSum     *Decimal                         `json:"prepaymentQrSum,omitempty"`     // Предоплата по QR-коду
 	Printed             *bool                            `json:"printed,omitempty"`             // Напечатан ли документ
 	Project             *NullValue[Project]              `json:"project,omitempty"`             // Метаданные проекта
 	Published           *bool                            `json:"published,omitempty"`           // Опубликован ли документ
-	QRSum               *float64                         `json:"qrSum,omitempty"`               // Оплачено по QR-коду
+	QRSum               *Decimal                         `json:"qrSum,omitempty"`               // Оплачено по QR-коду
 	Rate                *NullValue[Rate]                 `json:"rate,omitempty"`                // Валюта
 	RetailShift         *RetailShift                     `json:"retailShift,omitempty"`         // Метаданные Розничной смены
 	RetailStore         *RetailStore                     `json:"retailStore,omitempty"`         // Метаданные Точки продаж
@@ -59,14 +60,16 @@ type RetailDemand struct {
 	Shared              *bool                            `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]                `json:"state,omitempty"`               // Метаданные статуса Розничной продажи
 	Store               *Store                           `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                         `json:"sum,omitempty"`                 // Сумма Розничной продажи в копейках
+	Sum                 *Decimal                         `json:"sum,omitempty"`                 // Сумма Розничной продажи в копейках
 	SyncID              *string                          `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                       `json:"updated,omitempty"`             // Момент последнего обновления Розничной продажи
 	VatEnabled          *bool                            `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	VatIncluded         *bool                            `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	VatSum              *float64                         `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Decimal                         `json:"vatSum,omitempty"`              // Сумма НДС
 	TaxSystem           TaxSystem                        `json:"taxSystem,omitempty"`           // Код системы налогообложения
 	Attributes          Slice[Attribute]                 `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -105,7 +108,7 @@ func (retailDemand RetailDemand) GetApplicable() bool {
 }
 
 // GetCashSum возвращает Оплачено наличными.
-func (retailDemand RetailDemand) GetCashSum() float64 {
+func (retailDemand RetailDemand) GetCashSum() Decimal {
 	return Deref(retailDemand.CashSum)
 }
 
@@ -115,7 +118,7 @@ func (retailDemand RetailDemand) GetCheckNumber() string {
 }
 
 // GetCheckSum возвращает Сумму Чека.
-func (retailDemand RetailDemand) GetCheckSum() float64 {
+func (retailDemand RetailDemand) GetCheckSum() Decimal {
 	return Deref(retailDemand.CheckSum)
 }
 
@@ -200,7 +203,7 @@ func (retailDemand RetailDemand) GetName() string {
 }
 
 // GetNoCashSum возвращает Оплачено картой.
-func (retailDemand RetailDemand) GetNoCashSum() float64 {
+func (retailDemand RetailDemand) GetNoCashSum() Decimal {
 	return Deref(retailDemand.NoCashSum)
 }
 
@@ -225,7 +228,7 @@ func (retailDemand RetailDemand) GetOwner() Employee {
 }
 
 // GetPayedSum возвращает Сумму входящих платежей.
-func (retailDemand RetailDemand) GetPayedSum() float64 {
+func (retailDemand RetailDemand) GetPayedSum() Decimal {
 	return Deref(retailDemand.PayedSum)
 }
 
@@ -235,17 +238,17 @@ func (retailDemand RetailDemand) GetPositions() MetaArray[RetailDemandPosition]
 }
 
 // GetPrepaymentCashSum возвращает Предоплату наличными.
-func (retailDemand RetailDemand) GetPrepaymentCashSum() float64 {
+func (retailDemand RetailDemand) GetPrepaymentCashSum() Decimal {
 	return Deref(retailDemand.PrepaymentCashSum)
 }
 
 // GetPrepaymentNoCashSum возвращает Предоплату картой.
-func (retailDemand RetailDemand) GetPrepaymentNoCashSum() float64 {
+func (retailDemand RetailDemand) GetPrepaymentNoCashSum() Decimal {
 	return Deref(retailDemand.PrepaymentNoCashSum)
 }
 
 // GetPrepaymentQRSum возвращает Предоплату по QR-коду.
-func (retailDemand RetailDemand) GetPrepaymentQRSum() float64 {
+func (retailDemand RetailDemand) GetPrepaymentQRSum() Decimal {
 	return Deref(retailDemand.PrepaymentQRSum)
 }
 
@@ -265,7 +268,7 @@ func (retailDemand RetailDemand) GetPublished() bool {
 }
 
 // GetQRSum возвращает оплачено по QR-коду.
-func (retailDemand RetailDemand) GetQRSum() float64 {
+func (retailDemand RetailDemand) GetQRSum() Decimal {
 	return Deref(retailDemand.QRSum)
 }
 
@@ -305,7 +308,7 @@ func (retailDemand RetailDemand) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Розничной продажи в копейках.
-func (retailDemand RetailDemand) GetSum() float64 {
+func (retailDemand RetailDemand) GetSum() Decimal {
 	return Deref(retailDemand.Sum)
 }
 
@@ -330,7 +333,7 @@ func (retailDemand RetailDemand) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (retailDemand RetailDemand) GetVatSum() float64 {
+func (retailDemand RetailDemand) GetVatSum() Decimal {
 	return Deref(retailDemand.VatSum)
 }
 
@@ -369,7 +372,7 @@ func (retailDemand *RetailDemand) SetApplicable(applicable bool) *RetailDemand {
 }
 
 // SetCashSum устанавливает Оплачено наличными.
-func (retailDemand *RetailDemand) SetCashSum(cashSum float64) *RetailDemand {
+func (retailDemand *RetailDemand) SetCashSum(cashSum Decimal) *RetailDemand {
 	retailDemand.CashSum = &cashSum
 	return retailDemand
 }
@@ -381,7 +384,7 @@ func (retailDemand *RetailDemand) SetCheckNumber(checkNumber string) *RetailDema
 }
 
 // SetCheckSum устанавливает Сумму чека.
-func (retailDemand *RetailDemand) SetCheckSum(checkSum float64) *RetailDemand {
+func (retailDemand *RetailDemand) SetCheckSum(checkSum Decimal) *RetailDemand {
 	retailDemand.CheckSum = &checkSum
 	return retailDemand
 }
@@ -461,7 +464,7 @@ func (retailDemand *RetailDemand) SetName(name string) *RetailDemand {
 }
 
 // SetNoCashSum устанавливает Оплачено картой.
-func (retailDemand *RetailDemand) SetNoCashSum(noCashSum float64) *RetailDemand {
+func (retailDemand *RetailDemand) SetNoCashSum(noCashSum Decimal) *RetailDemand {
 	retailDemand.NoCashSum = &noCashSum
 	return retailDemand
 }
@@ -499,19 +502,19 @@ func (retailDemand *RetailDemand) SetPositions(positions ...*RetailDemandPositio
 }
 
 // SetPrepaymentCashSum устанавливает Предоплату наличными.
-func (retailDemand *RetailDemand) SetPrepaymentCashSum(prepaymentCashSum float64) *RetailDemand {
+func (retailDemand *RetailDemand) SetPrepaymentCashSum(prepaymentCashSum Decimal) *RetailDemand {
 	retailDemand.PrepaymentCashSum = &prepaymentCashSum
 	return retailDemand
 }
 
 // SetPrepaymentNoCashSum устанавливает Предоплату картой.
-func (retailDemand *RetailDemand) SetPrepaymentNoCashSum(prepaymentNoCashSum float64) *RetailDemand {
+func (retailDemand *RetailDemand) SetPrepaymentNoCashSum(prepaymentNoCashSum Decimal) *RetailDemand {
 	retailDemand.PrepaymentNoCashSum = &prepaymentNoCashSum
 	return retailDemand
 }
 
 // SetPrepaymentQRSum устанавливает Предоплату по QR-коду.
-func (retailDemand *RetailDemand) SetPrepaymentQRSum(prepaymentQRSum float64) *RetailDemand {
+func (retailDemand *RetailDemand) SetPrepaymentQRSum(prepaymentQRSum Decimal) *RetailDemand {
 	retailDemand.PrepaymentQRSum = &prepaymentQRSum
 	return retailDemand
 }
@@ -525,7 +528,7 @@ func (retailDemand *RetailDemand) SetProject(project *Project) *RetailDemand {
 }
 
 // SetQRSum устанавливает Оплачено по QR-коду.
-func (retailDemand *RetailDemand) SetQRSum(qrSum float64) *RetailDemand {
+func (retailDemand *RetailDemand) SetQRSum(qrSum Decimal) *RetailDemand {
 	retailDemand.QRSum = &qrSum
 	return retailDemand
 }
@@ -649,16 +652,18 @@ func (retailDemand *RetailDemand) Delete(ctx context.Context, client *Client) (b
 type RetailDemandPosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
-	Cost       *float64            `json:"cost,omitempty"`       // Себестоимость (только для услуг)
+	Cost       *Decimal            `json:"cost,omitempty"`       // Себестоимость (только для услуг)
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	Things     Slice[string]       `json:"things,omitempty"`     // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -672,7 +677,7 @@ func (retailDemandPosition RetailDemandPosition) GetAssortment() AssortmentPosit
 }
 
 // GetCost возвращает Себестоимость (только для услуг).
-func (retailDemandPosition RetailDemandPosition) GetCost() float64 {
+func (retailDemandPosition RetailDemandPosition) GetCost() Decimal {
 	return Deref(retailDemandPosition.Cost)
 }
 
@@ -694,7 +699,7 @@ func (retailDemandPosition RetailDemandPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (retailDemandPosition RetailDemandPosition) GetPrice() float64 {
+func (retailDemandPosition RetailDemandPosition) GetPrice() Decimal {
 	return Deref(retailDemandPosition.Price)
 }
 
@@ -702,7 +707,7 @@ func (retailDemandPosition RetailDemandPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (retailDemandPosition RetailDemandPosition) GetQuantity() float64 {
+func (retailDemandPosition RetailDemandPosition) GetQuantity() Decimal {
 	return Deref(retailDemandPosition.Quantity)
 }
 
@@ -740,7 +745,7 @@ func (retailDemandPosition *RetailDemandPosition) SetAssortment(assortment Assor
 }
 
 // SetCost устанавливает Себестоимость (только для услуг).
-func (retailDemandPosition *RetailDemandPosition) SetCost(cost float64) *RetailDemandPosition {
+func (retailDemandPosition *RetailDemandPosition) SetCost(cost Decimal) *RetailDemandPosition {
 	retailDemandPosition.Cost = &cost
 	return retailDemandPosition
 }
@@ -760,7 +765,7 @@ func (retailDemandPosition *RetailDemandPosition) SetPack(pack *Pack) *RetailDem
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (retailDemandPosition *RetailDemandPosition) SetPrice(price float64) *RetailDemandPosition {
+func (retailDemandPosition *RetailDemandPosition) SetPrice(price Decimal) *RetailDemandPosition {
 	retailDemandPosition.Price = &price
 	return retailDemandPosition
 }
@@ -769,7 +774,7 @@ func (retailDemandPosition *RetailDemandPosition) SetPrice(price float64) *Retai
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (retailDemandPosition *RetailDemandPosition) SetQuantity(quantity float64) *RetailDemandPosition {
+func (retailDemandPosition *RetailDemandPosition) SetQuantity(quantity Decimal) *RetailDemandPosition {
 	retailDemandPosition.Quantity = &quantity
 	return retailDemandPosition
 }
@@ -838,6 +843,11 @@ type RetailDemandService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailDemand], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех розничных продаж постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDemand, error]
+
 	// Create выполняет запрос на создание розничной продажи.
 	// Обязательные поля для заполнения:
 	//	- retailShift (Ссылка на Розничную смену, в рамках которой происходит продажа)
@@ -901,6 +911,11 @@ type RetailDemandService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[RetailDemandPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*RetailDemandPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -36,10 +37,12 @@ type RetailDrawerCashIn struct {
 	Rate         *NullValue[Rate]  `json:"rate,omitempty"`         // Валюта
 	Shared       *bool             `json:"shared,omitempty"`       // Общий доступ
 	State        *NullValue[State] `json:"state,omitempty"`        // Метаданные статуса Внесения денег
-	Sum          *float64          `json:"sum,omitempty"`          // Сумма Внесения денег в копейках
+	Sum          *Decimal          `json:"sum,omitempty"`          // Сумма Внесения денег в копейках
 	SyncID       *string           `json:"syncId,omitempty"`       // ID синхронизации
 	Updated      *Timestamp        `json:"updated,omitempty"`      // Момент последнего обновления Внесения денег
 	Attributes   Slice[Attribute]  `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -163,7 +166,7 @@ func (retailDrawerCashIn RetailDrawerCashIn) GetState() State {
 }
 
 // GetSum возвращает Сумму Перемещения в копейках.
-func (retailDrawerCashIn RetailDrawerCashIn) GetSum() float64 {
+func (retailDrawerCashIn RetailDrawerCashIn) GetSum() Decimal {
 	return Deref(retailDrawerCashIn.Sum)
 }
 
@@ -339,6 +342,11 @@ type RetailDrawerCashInService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailDrawerCashIn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех внесений денег постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDrawerCashIn, error]
+
 	// Create выполняет запрос на создание внесения денег.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -37,10 +38,12 @@ type RetailDrawerCashOut struct {
 	Rate         *NullValue[Rate]  `json:"rate,omitempty"`         // Валюта
 	Shared       *bool             `json:"shared,omitempty"`       // Общий доступ
 	State        *NullValue[State] `json:"state,omitempty"`        // Метаданные статуса Выплаты денег
-	Sum          *float64          `json:"sum,omitempty"`          // Сумма Выплаты денег установленной валюте
+	Sum          *Decimal          `json:"sum,omitempty"`          // Сумма Выплаты денег установленной валюте
 	SyncID       *string           `json:"syncId,omitempty"`       // ID синхронизации
 	Updated      *Timestamp        `json:"updated,omitempty"`      // Момент последнего обновления Выплаты денег
 	Attributes   Slice[Attribute]  `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -169,7 +172,7 @@ func (retailDrawerCashOut RetailDrawerCashOut) GetState() State {
 }
 
 // GetSum возвращает Сумму Выплаты денег в установленной валюте.
-func (retailDrawerCashOut RetailDrawerCashOut) GetSum() float64 {
+func (retailDrawerCashOut RetailDrawerCashOut) GetSum() Decimal {
 	return Deref(retailDrawerCashOut.Sum)
 }
 
@@ -351,6 +354,11 @@ type RetailDrawerCashOutService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailDrawerCashOut], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех выплат денег постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailDrawerCashOut, error]
+
 	// Create выполняет запрос на создание выплаты денег.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -20,7 +21,7 @@ type RetailSalesReturn struct {
 	AgentAccount        *AgentAccount                         `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	Applicable          *bool                                 `json:"applicable,omitempty"`          // Отметка о проведении
 	VatIncluded         *bool                                 `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	CashSum             *float64                              `json:"cashSum,omitempty"`             // Оплачено наличными
+	CashSum             *Decimal                              `json:"cashSum,omitempty"`             // Оплачено наличными
 	Code                *string                               `json:"code,omitempty"`                // Код Розничного возврата
 	Contract            *NullValue[Contract]                  `json:"contract,omitempty"`            // Метаданные договора
 	Created             *Timestamp                            `json:"created,omitempty"`             // Дата создания
@@ -33,7 +34,7 @@ type RetailSalesReturn struct {
 	Meta                *Meta                                 `json:"meta,omitempty"`                // Метаданные Розничного возврата
 	Moment              *Timestamp                            `json:"moment,omitempty"`              // Дата документа
 	OrganizationAccount *AgentAccount                         `json:"organizationAccount,omitempty"` // Метаданные счета юрлица
-	NoCashSum           *float64                              `json:"noCashSum,omitempty"`           // Оплачено картой
+	NoCashSum           *Decimal                              `json:"noCashSum,omitempty"`           // Оплачено картой
 	SyncID              *string                               `json:"syncId,omitempty"`              // ID синхронизации
 	AccountID           *string                               `json:"accountId,omitempty"`           // ID учётной записи
 	Owner               *Employee                             `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
@@ -41,20 +42,22 @@ type RetailSalesReturn struct {
 	Printed             *bool                                 `json:"printed,omitempty"`             // Напечатан ли документ
 	Project             *NullValue[Project]                   `json:"project,omitempty"`             // Метаданные проекта
 	Published           *bool                                 `json:"published,omitempty"`           // Опубликован ли документ
-	QRSum               *float64                              `json:"qrSum,omitempty"`               // Оплачено по QR-коду
+	QRSum               *Decimal                              `json:"qrSum,omitempty"`               // Оплачено по QR-коду
 	Rate                *NullValue[Rate]                      `json:"rate,omitempty"`                // Валюта
 	RetailShift         *RetailShift                          `json:"retailShift,omitempty"`         // Метаданные Розничной смены
 	RetailStore         *RetailStore                          `json:"retailStore,omitempty"`         // Метаданные Точки продаж
 	Shared              *bool                                 `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]                     `json:"state,omitempty"`               // Метаданные статуса Розничного возврата
 	Store               *Store                                `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                              `json:"sum,omitempty"`                 // Сумма Розничного возврата в копейках
+	Sum                 *Decimal                              `json:"sum,omitempty"`                 // Сумма Розничного возврата в копейках
 	Agent               *Agent                                `json:"agent,omitempty"`               // Метаданные контрагента
-	VatSum              *float64                              `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Decimal                              `json:"vatSum,omitempty"`              // Сумма НДС
 	Updated             *Timestamp                            `json:"updated,omitempty"`             // Момент последнего обновления Розничного возврата
 	VatEnabled          *bool                                 `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	TaxSystem           TaxSystem                             `json:"taxSystem,omitempty"`           // Код системы налогообложения
 	Attributes          Slice[Attribute]                      `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -98,7 +101,7 @@ func (retailSalesReturn RetailSalesReturn) GetVatIncluded() bool {
 }
 
 // GetCashSum возвращает Оплачено наличными.
-func (retailSalesReturn RetailSalesReturn) GetCashSum() float64 {
+func (retailSalesReturn RetailSalesReturn) GetCashSum() Decimal {
 	return Deref(retailSalesReturn.CashSum)
 }
 
@@ -163,7 +166,7 @@ func (retailSalesReturn RetailSalesReturn) GetOrganizationAccount() AgentAccount
 }
 
 // GetNoCashSum возвращает Оплачено картой.
-func (retailSalesReturn RetailSalesReturn) GetNoCashSum() float64 {
+func (retailSalesReturn RetailSalesReturn) GetNoCashSum() Decimal {
 	return Deref(retailSalesReturn.NoCashSum)
 }
 
@@ -203,7 +206,7 @@ func (retailSalesReturn RetailSalesReturn) GetPublished() bool {
 }
 
 // GetQRSum возвращает оплачено по QR-коду.
-func (retailSalesReturn RetailSalesReturn) GetQRSum() float64 {
+func (retailSalesReturn RetailSalesReturn) GetQRSum() Decimal {
 	return Deref(retailSalesReturn.QRSum)
 }
 
@@ -238,7 +241,7 @@ func (retailSalesReturn RetailSalesReturn) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Розничного возврата в копейках.
-func (retailSalesReturn RetailSalesReturn) GetSum() float64 {
+func (retailSalesReturn RetailSalesReturn) GetSum() Decimal {
 	return Deref(retailSalesReturn.Sum)
 }
 
@@ -248,7 +251,7 @@ func (retailSalesReturn RetailSalesReturn) GetAgent() Agent {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (retailSalesReturn RetailSalesReturn) GetVatSum() float64 {
+func (retailSalesReturn RetailSalesReturn) GetVatSum() Decimal {
 	return Deref(retailSalesReturn.VatSum)
 }
 
@@ -307,7 +310,7 @@ func (retailSalesReturn *RetailSalesReturn) SetVatIncluded(vatIncluded bool) *Re
 }
 
 // SetCashSum устанавливает Оплачено наличными.
-func (retailSalesReturn *RetailSalesReturn) SetCashSum(cashSum float64) *RetailSalesReturn {
+func (retailSalesReturn *RetailSalesReturn) SetCashSum(cashSum Decimal) *RetailSalesReturn {
 	retailSalesReturn.CashSum = &cashSum
 	return retailSalesReturn
 }
@@ -375,7 +378,7 @@ func (retailSalesReturn *RetailSalesReturn) SetOrganizationAccount(organizationA
 }
 
 // SetNoCashSum устанавливает Оплачено картой.
-func (retailSalesReturn *RetailSalesReturn) SetNoCashSum(noCashSum float64) *RetailSalesReturn {
+func (retailSalesReturn *RetailSalesReturn) SetNoCashSum(noCashSum Decimal) *RetailSalesReturn {
 	retailSalesReturn.NoCashSum = &noCashSum
 	return retailSalesReturn
 }
@@ -411,7 +414,7 @@ func (retailSalesReturn *RetailSalesReturn) SetProject(project *Project) *Retail
 }
 
 // SetQRSum устанавливает Оплачено по QR-коду.
-func (retailSalesReturn *RetailSalesReturn) SetQRSum(qrSum float64) *RetailSalesReturn {
+func (retailSalesReturn *RetailSalesReturn) SetQRSum(qrSum Decimal) *RetailSalesReturn {
 	retailSalesReturn.QRSum = &qrSum
 	return retailSalesReturn
 }
@@ -527,16 +530,18 @@ func (retailSalesReturn *RetailSalesReturn) Delete(ctx context.Context, client *
 type RetailSalesReturnPosition struct {
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учётной записи
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
-	Cost       *float64            `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
+	Cost       *Decimal            `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	ID         *string             `json:"id,omitempty"`         // ID позиции
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	Things     Slice[string]       `json:"things,omitempty"`     // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -550,7 +555,7 @@ func (retailSalesReturnPosition RetailSalesReturnPosition) GetAssortment() Assor
 }
 
 // GetCost возвращает Себестоимость (выводится, если документ был создан без основания).
-func (retailSalesReturnPosition RetailSalesReturnPosition) GetCost() float64 {
+func (retailSalesReturnPosition RetailSalesReturnPosition) GetCost() Decimal {
 	return Deref(retailSalesReturnPosition.Cost)
 }
 
@@ -572,7 +577,7 @@ func (retailSalesReturnPosition RetailSalesReturnPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (retailSalesReturnPosition RetailSalesReturnPosition) GetPrice() float64 {
+func (retailSalesReturnPosition RetailSalesReturnPosition) GetPrice() Decimal {
 	return Deref(retailSalesReturnPosition.Price)
 }
 
@@ -580,7 +585,7 @@ func (retailSalesReturnPosition RetailSalesReturnPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (retailSalesReturnPosition RetailSalesReturnPosition) GetQuantity() float64 {
+func (retailSalesReturnPosition RetailSalesReturnPosition) GetQuantity() Decimal {
 	return Deref(retailSalesReturnPosition.Quantity)
 }
 
@@ -624,7 +629,7 @@ func (retailSalesReturnPosition *RetailSalesReturnPosition) SetAssortment(assort
 }
 
 // SetCost устанавливает Себестоимость  (выводится, если документ был создан без основания).
-func (retailSalesReturnPosition *RetailSalesReturnPosition) SetCost(cost float64) *RetailSalesReturnPosition {
+func (retailSalesReturnPosition *RetailSalesReturnPosition) SetCost(cost Decimal) *RetailSalesReturnPosition {
 	retailSalesReturnPosition.Cost = &cost
 	return retailSalesReturnPosition
 }
@@ -646,7 +651,7 @@ func (retailSalesReturnPosition *RetailSalesReturnPosition) SetPack(pack *Pack)
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (retailSalesReturnPosition *RetailSalesReturnPosition) SetPrice(price float64) *RetailSalesReturnPosition {
+func (retailSalesReturnPosition *RetailSalesReturnPosition) SetPrice(price Decimal) *RetailSalesReturnPosition {
 	retailSalesReturnPosition.Price = &price
 	return retailSalesReturnPosition
 }
@@ -655,7 +660,7 @@ func (retailSalesReturnPosition *RetailSalesReturnPosition) SetPrice(price float
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (retailSalesReturnPosition *RetailSalesReturnPosition) SetQuantity(quantity float64) *RetailSalesReturnPosition {
+func (retailSalesReturnPosition *RetailSalesReturnPosition) SetQuantity(quantity Decimal) *RetailSalesReturnPosition {
 	retailSalesReturnPosition.Quantity = &quantity
 	return retailSalesReturnPosition
 }
@@ -711,6 +716,11 @@ type RetailSalesReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailSalesReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех розничных возвратов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailSalesReturn, error]
+
 	// Create выполняет запрос на создание внесения денег.
 	// Обязательные поля для заполнения:
 	//	- name -(омер возврата)
@@ -777,6 +787,11 @@ type RetailSalesReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[RetailSalesReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*RetailSalesReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
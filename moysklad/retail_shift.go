@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -19,7 +20,7 @@ type RetailShift struct {
 	Shared              *bool                  `json:"shared,omitempty"`              // Общий доступ
 	AgentAccount        *AgentAccount          `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	VatIncluded         *bool                  `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	BankCommission      *float64               `json:"bankComission,omitempty"`       // Сумма комиссии эквайера за проведение безналичных платежей по банковской карте. Не может превышать общую сумму безналичных платежей по карте. Если не указано, заполняется 0 автоматически.
+	BankCommission      *Decimal               `json:"bankComission,omitempty"`       // Сумма комиссии эквайера за проведение безналичных платежей по банковской карте. Не может превышать общую сумму безналичных платежей по карте. Если не указано, заполняется 0 автоматически.
 	BankPercent         *float64               `json:"bankPercent,omitempty"`         // Комиссия банка-эквайера по операциям по карте (в процентах)
 	Name                *string                `json:"name,omitempty"`                // Наименование Розничной смены
 	CloseDate           *Timestamp             `json:"closeDate,omitempty"`           // Дата закрытия смены
@@ -39,14 +40,14 @@ type RetailShift struct {
 	OrganizationAccount *AgentAccount          `json:"organizationAccount,omitempty"` // Метаданные счета юрлица
 	Owner               *Employee              `json:"owner,omitempty"`               // Метаданные владельца (Сотрудника)
 	Printed             *bool                  `json:"printed,omitempty"`             // Напечатан ли документ
-	ProceedsCash        *float64               `json:"proceedsCash,omitempty"`        // Выручка наличными
-	ProceedsNoCash      *float64               `json:"proceedsNoCash,omitempty"`      // Выручка безнал
+	ProceedsCash        *Decimal               `json:"proceedsCash,omitempty"`        // Выручка наличными
+	ProceedsNoCash      *Decimal               `json:"proceedsNoCash,omitempty"`      // Выручка безнал
 	Published           *bool                  `json:"published,omitempty"`           // Опубликован ли документ
 	QRAcquire           *Agent                 `json:"qrAcquire,omitempty"`           // Метаданные Банка-эквайера по операциям по QR-коду
-	QRBankCommission    *float64               `json:"qrBankComission,omitempty"`     // Сумма комиссии эквайера за проведение безналичных платежей по QR-коду. Не может превышать общую сумму безналичных платежей по QR-коду. Если не указано, заполняется 0 автоматически.
+	QRBankCommission    *Decimal               `json:"qrBankComission,omitempty"`     // Сумма комиссии эквайера за проведение безналичных платежей по QR-коду. Не может превышать общую сумму безналичных платежей по QR-коду. Если не указано, заполняется 0 автоматически.
 	QRBankPercent       *float64               `json:"qrBankPercent,omitempty"`       // Комиссия банка-эквайера по операция по QR-коду (в процентах)
-	ReceivedCash        *float64               `json:"receivedCash,omitempty"`        // Получено наличными
-	ReceivedNoCash      *float64               `json:"receivedNoCash,omitempty"`      // Получено безнал
+	ReceivedCash        *Decimal               `json:"receivedCash,omitempty"`        // Получено наличными
+	ReceivedNoCash      *Decimal               `json:"receivedNoCash,omitempty"`      // Получено безнал
 	RetailStore         *RetailStore           `json:"retailStore,omitempty"`         // Метаданные точки продаж
 	Store               *Store                 `json:"store,omitempty"`               // Метаданные склада. Если не указано, заполняется с точки продаж автоматически
 	SyncID              *string                `json:"syncId,omitempty"`              // ID синхронизации
@@ -55,6 +56,8 @@ type RetailShift struct {
 	PaymentOperations   Slice[Payment]         `json:"paymentOperations,omitempty"`   // Коллекция метаданных платежных операций
 	Operations          Slice[RetailOperation] `json:"operations,omitempty"`          // Коллекция метаданных связанных операций
 	Attributes          Slice[Attribute]       `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -83,13 +86,13 @@ func (retailShift RetailShift) AsOperationIn() *Operation {
 }
 
 // GetProceedsSum возвращает сумму выручки.
-func (retailShift RetailShift) GetProceedsSum() float64 {
-	return retailShift.GetProceedsCash() + retailShift.GetProceedsNoCash()
+func (retailShift RetailShift) GetProceedsSum() Decimal {
+	return retailShift.GetProceedsCash().Add(retailShift.GetProceedsNoCash())
 }
 
 // GetReceivedSum возвращает сумму полученного.
-func (retailShift RetailShift) GetReceivedSum() float64 {
-	return retailShift.GetReceivedCash() + retailShift.GetReceivedNoCash()
+func (retailShift RetailShift) GetReceivedSum() Decimal {
+	return retailShift.GetReceivedCash().Add(retailShift.GetReceivedNoCash())
 }
 
 // GetOrganization возвращает Метаданные юрлица.
@@ -117,7 +120,7 @@ func (retailShift RetailShift) GetVatIncluded() bool {
 // Не может превышать общую сумму безналичных платежей по карте.
 //
 // Если не указано, заполняется 0 автоматически.
-func (retailShift RetailShift) GetBankCommission() float64 {
+func (retailShift RetailShift) GetBankCommission() Decimal {
 	return Deref(retailShift.BankCommission)
 }
 
@@ -217,12 +220,12 @@ func (retailShift RetailShift) GetPrinted() bool {
 }
 
 // GetProceedsCash возвращает Выручку наличными.
-func (retailShift RetailShift) GetProceedsCash() float64 {
+func (retailShift RetailShift) GetProceedsCash() Decimal {
 	return Deref(retailShift.ProceedsCash)
 }
 
 // GetProceedsNoCash возвращает Выручку безнала.
-func (retailShift RetailShift) GetProceedsNoCash() float64 {
+func (retailShift RetailShift) GetProceedsNoCash() Decimal {
 	return Deref(retailShift.ProceedsNoCash)
 }
 
@@ -241,7 +244,7 @@ func (retailShift RetailShift) GetQRAcquire() Agent {
 // Не может превышать общую сумму безналичных платежей по QR-коду.
 //
 // Если не указано, заполняется 0 автоматически.
-func (retailShift RetailShift) GetQRBankCommission() float64 {
+func (retailShift RetailShift) GetQRBankCommission() Decimal {
 	return Deref(retailShift.QRBankCommission)
 }
 
@@ -251,12 +254,12 @@ func (retailShift RetailShift) GetQRBankPercent() float64 {
 }
 
 // GetReceivedCash возвращает Получено наличными.
-func (retailShift RetailShift) GetReceivedCash() float64 {
+func (retailShift RetailShift) GetReceivedCash() Decimal {
 	return Deref(retailShift.ReceivedCash)
 }
 
 // GetReceivedNoCash возвращает Получено безнала.
-func (retailShift RetailShift) GetReceivedNoCash() float64 {
+func (retailShift RetailShift) GetReceivedNoCash() Decimal {
 	return Deref(retailShift.ReceivedNoCash)
 }
 
@@ -325,7 +328,7 @@ func (retailShift *RetailShift) SetVatIncluded(vatIncluded bool) *RetailShift {
 // Не может превышать общую сумму безналичных платежей по карте.
 //
 // Если не указано, заполняется 0 автоматически.
-func (retailShift *RetailShift) SetBankCommission(bankCommission float64) *RetailShift {
+func (retailShift *RetailShift) SetBankCommission(bankCommission Decimal) *RetailShift {
 	retailShift.BankCommission = &bankCommission
 	return retailShift
 }
@@ -417,7 +420,7 @@ func (retailShift *RetailShift) SetQRAcquire(qrAcquire AgentOrganizationConverte
 // Не может превышать общую сумму безналичных платежей по QR-коду.
 //
 // Если не указано, заполняется 0 автоматически.
-func (retailShift *RetailShift) SetQRBankCommission(qrBankCommission float64) *RetailShift {
+func (retailShift *RetailShift) SetQRBankCommission(qrBankCommission Decimal) *RetailShift {
 	retailShift.QRBankCommission = &qrBankCommission
 	return retailShift
 }
@@ -545,6 +548,11 @@ type RetailShiftService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailShift], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех розничных смен постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailShift, error]
+
 	// Create выполняет запрос на создание розничной смены.
 	// Обязательные поля для заполнения:
 	//	- organization (Метаданные юрлица)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -90,6 +91,8 @@ type RetailStore struct {
 	FilterAgentsTags                    Slice[string]               `json:"filterAgentsTags,omitempty"`                    // Коллекция групп покупателей, представленных в формате строк. Определяет группы, из которых выгружаются покупатели. Значения null игнорируются
 	CustomerOrderStates                 Slice[State]                `json:"customerOrderStates,omitempty"`                 // Метаданные статусов, в которых выгружаются заказы в точку продаж (если указано)
 	CreateAgentsTags                    Slice[string]               `json:"createAgentsTags,omitempty"`                    // Коллекция групп покупателей, представленных в формате строк. Определяет группы, в которые добавляются новые покупатели. Значения null игнорируются
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -949,6 +952,8 @@ type Cashier struct {
 	ID          *string      `json:"id,omitempty"`          // ID кассира
 	Meta        *Meta        `json:"meta,omitempty"`        // Метаданные кассира
 	RetailStore *RetailStore `json:"retailStore,omitempty"` // Метаданные точки продаж, к которой прикреплен кассир
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -1330,6 +1335,11 @@ type RetailStoreService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[RetailStore], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех точек продаж постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*RetailStore, error]
+
 	// Create выполняет запрос на создание точи продаж.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование точки продаж)
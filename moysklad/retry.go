@@ -0,0 +1,128 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"github.com/go-resty/resty/v2"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// RetryPolicy политика повторного выполнения запросов.
+//
+// Повтор выполняется при получении одного из статусов Statuses (по умолчанию 429, 502, 503, 504)
+// или при сетевой ошибке. Время ожидания перед повтором берётся из заголовков
+// X-Lognex-Retry-After и X-Lognex-Reset, а при их отсутствии вычисляется
+// как экспоненциальная задержка со случайным разбросом.
+//
+// Неидемпотентные методы (POST) повторяются только при установленном флаге RetryNonIdempotent.
+//
+// # Пример:
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:       "MS_TOKEN_HERE",
+//		RetryPolicy: moysklad.DefaultRetryPolicy(),
+//	})
+type RetryPolicy struct {
+	// Максимальное количество попыток, включая первую.
+	MaxAttempts int
+
+	// Начальная задержка экспоненциального ожидания.
+	MinWait time.Duration
+
+	// Максимальная задержка между попытками.
+	MaxWait time.Duration
+
+	// HTTP-статусы, при получении которых выполняется повтор.
+	// Если не указаны, используются 429, 502, 503, 504.
+	Statuses []int
+
+	// Разрешает повтор неидемпотентных запросов (POST).
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy возвращает политику повторов с настройками по умолчанию:
+// 5 попыток, задержка от 500 мс до 30 с.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		MinWait:     500 * time.Millisecond,
+		MaxWait:     30 * time.Second,
+	}
+}
+
+var defaultRetryStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// shouldRetry возвращает true, если запрос необходимо повторить.
+func (policy *RetryPolicy) shouldRetry(ctx context.Context, method string, attempt int, resp *resty.Response, err error) bool {
+	if policy == nil || attempt >= policy.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	if !isIdempotent(method) && !policy.RetryNonIdempotent {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, ErrCircuitOpen)
+	}
+
+	statuses := policy.Statuses
+	if len(statuses) == 0 {
+		statuses = defaultRetryStatuses
+	}
+
+	return slices.Contains(statuses, resp.StatusCode())
+}
+
+// backoff возвращает время ожидания перед следующей попыткой.
+func (policy *RetryPolicy) backoff(attempt int, resp *resty.Response) time.Duration {
+	if resp != nil {
+		if wait := retryAfter(resp.Header()); wait > 0 {
+			return min(wait, policy.maxWait())
+		}
+	}
+
+	wait := policy.MinWait
+	if wait <= 0 {
+		wait = 100 * time.Millisecond
+	}
+
+	for i := 1; i < attempt && wait < policy.maxWait(); i++ {
+		wait *= 2
+	}
+
+	wait = min(wait, policy.maxWait())
+
+	// случайный разброс в диапазоне [wait/2, wait]
+	return wait/2 + rand.N(wait/2+1)
+}
+
+func (policy *RetryPolicy) maxWait() time.Duration {
+	if policy.MaxWait <= 0 {
+		return time.Minute
+	}
+	return policy.MaxWait
+}
+
+// wait ожидает перед следующей попыткой с учётом контекста.
+func (policy *RetryPolicy) wait(ctx context.Context, attempt int, resp *resty.Response) error {
+	return sleepContext(ctx, policy.backoff(attempt, resp))
+}
+
+// isIdempotent возвращает true, если HTTP-метод является идемпотентным.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
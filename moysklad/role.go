@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Role Пользовательская роль.
@@ -17,6 +18,8 @@ type Role struct {
 	Meta        *Meta                `json:"meta,omitempty"`        // Метаданные пользовательской роли
 	Name        *string              `json:"name,omitempty"`        // Наименование пользовательской роли
 	Permissions *EmployeePermissions `json:"permissions,omitempty"` // Список пермиссий
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -91,6 +94,8 @@ func (role *Role) Delete(ctx context.Context, client *Client) (bool, *resty.Resp
 // AdminRole Роль администратора.
 type AdminRole struct {
 	Meta Meta `json:"meta,omitempty"` // метаданные роли
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -106,6 +111,8 @@ func (AdminRole) MetaType() MetaType {
 // IndividualRole Индивидуальная роль.
 type IndividualRole struct {
 	Meta Meta `json:"meta,omitempty"` // метаданные роли
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -121,6 +128,8 @@ func (IndividualRole) MetaType() MetaType {
 // CashierRole Роль кассира.
 type CashierRole struct {
 	Meta Meta `json:"meta,omitempty"` // метаданные роли
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -136,6 +145,8 @@ func (CashierRole) MetaType() MetaType {
 // WorkerRole Роль сотрудника производства.
 type WorkerRole struct {
 	Meta Meta `json:"meta,omitempty"` // метаданные роли
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // String реализует интерфейс [fmt.Stringer].
@@ -329,6 +340,11 @@ type RoleService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Role], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех пользовательских ролей постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Role, error]
+
 	// Create выполняет запрос на создание пользовательской роли.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование пользовательской роли)
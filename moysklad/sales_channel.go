@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -28,6 +29,8 @@ type SalesChannel struct {
 	Shared       *bool            `json:"shared,omitempty"`       // Общий доступ
 	Updated      *Timestamp       `json:"update,omitempty"`       // Момент последнего обновления Канала продаж
 	Type         SalesChannelType `json:"type,omitempty"`         // Тип Канала продаж
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -274,6 +277,11 @@ type SalesChannelService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[SalesChannel], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех каналов продаж постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*SalesChannel, error]
+
 	// Create выполняет запрос на создание канала продаж.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование Канала продаж)
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -16,7 +17,7 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-vozwrat-pokupatelq
 type SalesReturn struct {
 	Positions           *MetaArray[SalesReturnPosition] `json:"positions,omitempty"`           // Метаданные позиций Возврата Покупателя
-	VatSum              *float64                        `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Decimal                        `json:"vatSum,omitempty"`              // Сумма НДС
 	AgentAccount        *AgentAccount                   `json:"agentAccount,omitempty"`        // Метаданные счета контрагента
 	Applicable          *bool                           `json:"applicable,omitempty"`          // Отметка о проведении
 	FactureOut          *FactureOut                     `json:"factureOut,omitempty"`          // Ссылка на Счет-фактуру выданный, с которым связан этот возврат
@@ -44,7 +45,7 @@ type SalesReturn struct {
 	Shared              *bool                           `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]               `json:"state,omitempty"`               // Метаданные статуса Возврата Покупателя
 	Store               *Store                          `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                        `json:"sum,omitempty"`                 // Сумма Возврата Покупателя в копейках
+	Sum                 *Decimal                        `json:"sum,omitempty"`                 // Сумма Возврата Покупателя в копейках
 	SyncID              *string                         `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                      `json:"updated,omitempty"`             // Момент последнего обновления Возврата Покупателя
 	VatEnabled          *bool                           `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
@@ -53,8 +54,10 @@ type SalesReturn struct {
 	Demand              *Demand                         `json:"demand,omitempty"`              // Ссылка на отгрузку, по которой произошел возврат
 	Losses              Slice[Loss]                     `json:"losses,omitempty"`              // Массив ссылок на связанные списания
 	Payments            Slice[Payment]                  `json:"payments,omitempty"`            // Массив ссылок на связанные платежи
-	PayedSum            *float64                        `json:"payedSum,omitempty"`            // Сумма исходящих платежей по возврату покупателя
+	PayedSum            *Decimal                        `json:"payedSum,omitempty"`            // Сумма исходящих платежей по возврату покупателя
 	Attributes          Slice[Attribute]                `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -80,7 +83,7 @@ func (salesReturn SalesReturn) GetPositions() MetaArray[SalesReturnPosition] {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (salesReturn SalesReturn) GetVatSum() float64 {
+func (salesReturn SalesReturn) GetVatSum() Decimal {
 	return Deref(salesReturn.VatSum)
 }
 
@@ -220,7 +223,7 @@ func (salesReturn SalesReturn) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Возврата Покупателя в копейках.
-func (salesReturn SalesReturn) GetSum() float64 {
+func (salesReturn SalesReturn) GetSum() Decimal {
 	return Deref(salesReturn.Sum)
 }
 
@@ -265,7 +268,7 @@ func (salesReturn SalesReturn) GetPayments() Slice[Payment] {
 }
 
 // GetPayedSum возвращает Сумму исходящих платежей по возврату покупателя.
-func (salesReturn SalesReturn) GetPayedSum() float64 {
+func (salesReturn SalesReturn) GetPayedSum() Decimal {
 	return Deref(salesReturn.PayedSum)
 }
 
@@ -528,20 +531,22 @@ func (salesReturn *SalesReturn) Delete(ctx context.Context, client *Client) (boo
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-vozwrat-pokupatelq-vozwraty-pokupatelej-pozicii-vozwrata-pokupatelq
 type SalesReturnPosition struct {
 	ID         *string             `json:"id,omitempty"`         // ID позиции
-	Price      *float64            `json:"price,omitempty"`      // Цена товара/услуги в копейках
-	Cost       *float64            `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
+	Price      *Decimal            `json:"price,omitempty"`      // Цена товара/услуги в копейках
+	Cost       *Decimal            `json:"cost,omitempty"`       // Себестоимость (выводится, если документ был создан без основания)
 	Country    *Country            `json:"country,omitempty"`    // Метаданные Страны
 	Discount   *float64            `json:"discount,omitempty"`   // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	GTD        *GTD                `json:"gtd,omitempty"`        // ГТД
 	Assortment *AssortmentPosition `json:"assortment,omitempty"` // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
 	Pack       *Pack               `json:"pack,omitempty"`       // Упаковка Товара
 	AccountID  *string             `json:"accountId,omitempty"`  // ID учетной записи
-	Quantity   *float64            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Quantity   *Decimal            `json:"quantity,omitempty"`   // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Slot       *Slot               `json:"slot,omitempty"`       // Ячейка на складе
 	Stock      *Stock              `json:"stock,omitempty"`      // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	Vat        *int                `json:"vat,omitempty"`        // НДС, которым облагается текущая позиция
 	VatEnabled *bool               `json:"vatEnabled,omitempty"` // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
 	Things     Slice[string]       `json:"things,omitempty"`     // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -555,7 +560,7 @@ func (salesReturnPosition SalesReturnPosition) GetAssortment() AssortmentPositio
 }
 
 // GetCost возвращает Себестоимость (выводится, если документ был создан без основания).
-func (salesReturnPosition SalesReturnPosition) GetCost() float64 {
+func (salesReturnPosition SalesReturnPosition) GetCost() Decimal {
 	return Deref(salesReturnPosition.Cost)
 }
 
@@ -587,7 +592,7 @@ func (salesReturnPosition SalesReturnPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (salesReturnPosition SalesReturnPosition) GetPrice() float64 {
+func (salesReturnPosition SalesReturnPosition) GetPrice() Decimal {
 	return Deref(salesReturnPosition.Price)
 }
 
@@ -595,7 +600,7 @@ func (salesReturnPosition SalesReturnPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (salesReturnPosition SalesReturnPosition) GetQuantity() float64 {
+func (salesReturnPosition SalesReturnPosition) GetQuantity() Decimal {
 	return Deref(salesReturnPosition.Quantity)
 }
 
@@ -644,7 +649,7 @@ func (salesReturnPosition *SalesReturnPosition) SetAssortment(assortment Assortm
 }
 
 // SetCost устанавливает Себестоимость (выводится, если документ был создан без основания).
-func (salesReturnPosition *SalesReturnPosition) SetCost(cost float64) *SalesReturnPosition {
+func (salesReturnPosition *SalesReturnPosition) SetCost(cost Decimal) *SalesReturnPosition {
 	salesReturnPosition.Cost = &cost
 	return salesReturnPosition
 }
@@ -678,7 +683,7 @@ func (salesReturnPosition *SalesReturnPosition) SetPack(pack *Pack) *SalesReturn
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (salesReturnPosition *SalesReturnPosition) SetPrice(price float64) *SalesReturnPosition {
+func (salesReturnPosition *SalesReturnPosition) SetPrice(price Decimal) *SalesReturnPosition {
 	salesReturnPosition.Price = &price
 	return salesReturnPosition
 }
@@ -687,7 +692,7 @@ func (salesReturnPosition *SalesReturnPosition) SetPrice(price float64) *SalesRe
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (salesReturnPosition *SalesReturnPosition) SetQuantity(quantity float64) *SalesReturnPosition {
+func (salesReturnPosition *SalesReturnPosition) SetQuantity(quantity Decimal) *SalesReturnPosition {
 	salesReturnPosition.Quantity = &quantity
 	return salesReturnPosition
 }
@@ -751,6 +756,11 @@ type SalesReturnService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[SalesReturn], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех возвратов покупателей постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*SalesReturn, error]
+
 	// Create выполняет запрос на создание возврата покупателя.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -816,6 +826,11 @@ type SalesReturnService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[SalesReturnPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*SalesReturnPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
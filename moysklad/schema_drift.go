@@ -0,0 +1,216 @@
+package moysklad
+
+//go:generate go run ./internal/enumgen -src . -out enums_gen.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// SchemaDriftKind вид расхождения ответа API со структурами библиотеки.
+type SchemaDriftKind string
+
+const (
+	SchemaDriftUnknownField SchemaDriftKind = "unknown_field" // Поле, не описанное в структуре
+	SchemaDriftUnknownValue SchemaDriftKind = "unknown_value" // Значение, отсутствующее в перечислении
+)
+
+// SchemaDrift расхождение ответа API со структурами библиотеки: новое поле или новое значение перечисления.
+type SchemaDrift struct {
+	Kind  SchemaDriftKind // Вид расхождения
+	URI   string          // Путь запроса
+	Type  string          // Тип, в котором обнаружено расхождение, например CustomerOrderPosition или TaxSystem
+	Path  string          // Путь к полю в ответе, например rows[].positions.rows[].newField
+	Field string          // Название поля JSON
+	Value string          // Неизвестное значение перечисления (для SchemaDriftUnknownValue)
+}
+
+// String реализует интерфейс [fmt.Stringer].
+func (drift SchemaDrift) String() string {
+	if drift.Kind == SchemaDriftUnknownValue {
+		return drift.URI + ": " + drift.Type + " " + drift.Path + " = " + drift.Value
+	}
+	return drift.URI + ": " + drift.Type + " " + drift.Path
+}
+
+// enumValues возвращает множество значений перечисления.
+func enumValues[E ~string](values ...E) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[string(value)] = true
+	}
+	return set
+}
+
+var (
+	unmarshalerType  = reflect.TypeFor[json.Unmarshaler]()
+	keeperType       = reflect.TypeFor[interface{ KeepUnknownFields(bool) }]()
+	rawMetaTyperType = reflect.TypeFor[RawMetaTyper]()
+	metaTyperType    = reflect.TypeFor[MetaTyper]()
+	packagePath      = reflect.TypeFor[Meta]().PkgPath()
+)
+
+// schemaDriftDetector сопоставляет тело ответа с типом результата.
+type schemaDriftDetector struct {
+	uri    string
+	report func(SchemaDrift)
+	seen   map[SchemaDrift]bool // Каждое расхождение сообщается один раз за ответ
+}
+
+// detectSchemaDrift сообщает обработчику OnSchemaDrift о полях и значениях перечислений
+// тела ответа body, которые не описаны в типе результата typ.
+func (client *Client) detectSchemaDrift(uri string, typ reflect.Type, body []byte) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return
+	}
+
+	detector := &schemaDriftDetector{
+		uri:    uri,
+		report: client.onSchemaDrift,
+		seen:   make(map[SchemaDrift]bool),
+	}
+	detector.walk(value, typ, "")
+}
+
+func (detector *schemaDriftDetector) walk(value any, typ reflect.Type, path string) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if known, ok := knownEnumValues[typ]; ok {
+		if s, ok := value.(string); ok && s != "" && !known[s] {
+			detector.add(SchemaDrift{Kind: SchemaDriftUnknownValue, Type: typeName(typ), Path: path, Field: fieldName(path), Value: s})
+		}
+		return
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		// NullValue декодирует значение во вложенное поле
+		if typ.PkgPath() == packagePath && strings.HasPrefix(typ.Name(), "NullValue[") {
+			detector.walk(value, typ.Field(0).Type, path)
+			return
+		}
+
+		object, ok := value.(map[string]any)
+		pointer := reflect.PointerTo(typ)
+
+		// объект, который может быть сущностью разных типов (позиция ассортимента, контрагент, операция и т.д.),
+		// сверяется с типом сущности, указанным в meta.type
+		if ok && pointer.Implements(rawMetaTyperType) {
+			if concrete, found := concreteTypes(typ)[metaTypeOf(object)]; found {
+				detector.walk(value, concrete, path)
+			}
+			return
+		}
+
+		// остальные типы с собственным декодированием (время и т.д.) не проверяются,
+		// кроме сущностей, сохраняющих неизвестные поля
+		if pointer.Implements(unmarshalerType) && !pointer.Implements(keeperType) {
+			return
+		}
+
+		if !ok {
+			return
+		}
+
+		fields := jsonFields(typ)
+		for _, name := range slices.Sorted(maps.Keys(object)) {
+			fieldPath := joinPath(path, name)
+			if field, ok := fields[strings.ToLower(name)]; ok {
+				detector.walk(object[name], field.typ, fieldPath)
+				continue
+			}
+			detector.add(SchemaDrift{Kind: SchemaDriftUnknownField, Type: typeName(typ), Path: fieldPath, Field: name})
+		}
+
+	case reflect.Slice, reflect.Array:
+		if elements, ok := value.([]any); ok {
+			for _, element := range elements {
+				detector.walk(element, typ.Elem(), path+"[]")
+			}
+		}
+
+	case reflect.Map:
+		if object, ok := value.(map[string]any); ok {
+			for _, name := range slices.Sorted(maps.Keys(object)) {
+				detector.walk(object[name], typ.Elem(), joinPath(path, "*"))
+			}
+		}
+	}
+}
+
+// metaTypeOf возвращает код сущности из поля meta.type объекта.
+func metaTypeOf(object map[string]any) MetaType {
+	meta, _ := object["meta"].(map[string]any)
+	metaType, _ := meta["type"].(string)
+	return MetaType(metaType)
+}
+
+// concreteTypesCache типы сущностей по коду сущности для типов, объединяющих сущности разных типов.
+var concreteTypesCache sync.Map
+
+// concreteTypes возвращает типы сущностей по коду сущности, к которым приводится тип typ
+// методами вида As<Тип>, например [AssortmentPosition.AsProduct] или [Agent.AsCounterparty].
+func concreteTypes(typ reflect.Type) map[MetaType]reflect.Type {
+	if types, ok := concreteTypesCache.Load(typ); ok {
+		return types.(map[MetaType]reflect.Type)
+	}
+
+	types := make(map[MetaType]reflect.Type)
+	pointer := reflect.PointerTo(typ)
+	for i := range pointer.NumMethod() {
+		method := pointer.Method(i)
+		if !strings.HasPrefix(method.Name, "As") || method.Type.NumIn() != 1 || method.Type.NumOut() != 1 {
+			continue
+		}
+
+		result := method.Type.Out(0)
+		if result.Kind() != reflect.Pointer || result.Elem() == typ || !result.Implements(metaTyperType) {
+			continue
+		}
+
+		if metaType := reflect.New(result.Elem()).Interface().(MetaTyper).MetaType(); metaType != "" {
+			types[metaType] = result.Elem()
+		}
+	}
+
+	concreteTypesCache.Store(typ, types)
+	return types
+}
+
+func (detector *schemaDriftDetector) add(drift SchemaDrift) {
+	drift.URI = detector.uri
+	if detector.seen[drift] {
+		return
+	}
+	detector.seen[drift] = true
+	detector.report(drift)
+}
+
+// typeName возвращает название типа без пути пакета, например List[Product].
+func typeName(typ reflect.Type) string {
+	return strings.ReplaceAll(typ.Name(), packagePath+".", "")
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// fieldName возвращает название поля из пути path.
+func fieldName(path string) string {
+	path = strings.TrimRight(path, "[]")
+	return path[strings.LastIndex(path, ".")+1:]
+}
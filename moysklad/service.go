@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -45,6 +46,8 @@ type Service struct {
 	PaymentItemType     PaymentItem          `json:"paymentItemType,omitempty"`     // Признак предмета расчета
 	TaxSystem           TaxSystem            `json:"taxSystem,omitempty"`           // Код системы налогообложения
 	Attributes          Slice[Attribute]     `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -455,6 +458,11 @@ type ServiceService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Service], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех услуг постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Service, error]
+
 	// Create выполняет запрос на создание услуги.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование услуги)
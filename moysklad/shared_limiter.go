@@ -0,0 +1,141 @@
+package moysklad
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// SharedLimits ограничения, которые соблюдает [NewSharedLimiter] совместно со всеми процессами,
+// использующими тот же ключ.
+type SharedLimits struct {
+	// Количество запросов за период Window.
+	Requests int
+
+	// Период, на который выдаётся лимит запросов.
+	Window time.Duration
+
+	// Количество параллельных запросов.
+	Parallel int
+
+	// Время, по истечении которого слот параллельного запроса считается освобождённым.
+	// Защищает от утечки слотов при аварийном завершении процесса.
+	LeaseTTL time.Duration
+}
+
+// DefaultSharedLimits возвращает ограничения МойСклад по умолчанию:
+// не более 45 запросов за 3 секунды и не более 5 параллельных запросов.
+func DefaultSharedLimits() SharedLimits {
+	return SharedLimits{
+		Requests: MaxQueriesPerSecond * 3,
+		Window:   rateLimitWindow,
+		Parallel: MaxQueriesPerUser,
+		LeaseTTL: time.Minute,
+	}
+}
+
+// SharedLimitBackend описывает хранилище общего состояния ограничителя запросов.
+//
+// Все методы должны выполняться атомарно относительно других процессов, использующих то же хранилище.
+// Из коробки доступна реализация на основе файловых блокировок [NewFileLimitBackend];
+// для распределённых систем можно реализовать интерфейс поверх Redis или другого хранилища.
+type SharedLimitBackend interface {
+	// Reserve пытается занять слот запроса по ключу key с учётом ограничений limits.
+	// В случае успеха возвращает идентификатор занятого слота lease.
+	// Если слот недоступен, возвращает пустой lease и время wait, через которое стоит повторить попытку.
+	Reserve(ctx context.Context, key string, limits SharedLimits) (lease string, wait time.Duration, err error)
+
+	// Release освобождает слот lease по ключу key.
+	Release(ctx context.Context, key, lease string) error
+
+	// Block запрещает выполнение запросов по ключу key до момента until.
+	Block(ctx context.Context, key string, until time.Time) error
+}
+
+// NewSharedLimiter возвращает [Limiter], который согласует ограничения запросов между процессами
+// через общее хранилище backend.
+//
+// В качестве ключа key следует использовать идентификатор учётной записи или логин пользователя:
+// все клиенты с одинаковым ключом совместно соблюдают ограничения limits.
+//
+// # Пример:
+//
+//	backend, err := moysklad.NewFileLimitBackend("/var/run/moysklad")
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	client := moysklad.New(moysklad.Config{
+//		Token:   "MS_TOKEN_HERE",
+//		Limiter: moysklad.NewSharedLimiter(backend, "admin@company", moysklad.DefaultSharedLimits()),
+//	})
+func NewSharedLimiter(backend SharedLimitBackend, key string, limits SharedLimits) Limiter {
+	return &sharedLimiter{backend: backend, key: key, limits: limits}
+}
+
+type sharedLimiter struct {
+	backend SharedLimitBackend
+	key     string
+	leases  []string // Слоты, занятые текущим процессом
+	limits  SharedLimits
+	mu      sync.Mutex
+}
+
+// sharedLimiterTimeout ограничивает время обращения к хранилищу в методах, не принимающих контекст.
+const sharedLimiterTimeout = 5 * time.Second
+
+func (limiter *sharedLimiter) Acquire(ctx context.Context) error {
+	for {
+		lease, wait, err := limiter.backend.Reserve(ctx, limiter.key, limiter.limits)
+		if err != nil {
+			return err
+		}
+
+		if lease != "" {
+			limiter.mu.Lock()
+			limiter.leases = append(limiter.leases, lease)
+			limiter.mu.Unlock()
+			return nil
+		}
+
+		if err = sleepContext(ctx, max(wait, time.Millisecond)); err != nil {
+			return err
+		}
+	}
+}
+
+func (limiter *sharedLimiter) Release() {
+	limiter.mu.Lock()
+	if len(limiter.leases) == 0 {
+		limiter.mu.Unlock()
+		return
+	}
+	lease := limiter.leases[len(limiter.leases)-1]
+	limiter.leases = limiter.leases[:len(limiter.leases)-1]
+	limiter.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), sharedLimiterTimeout)
+	defer cancel()
+
+	// при ошибке слот будет освобождён хранилищем по истечении LeaseTTL
+	_ = limiter.backend.Release(ctx, limiter.key, lease)
+}
+
+func (limiter *sharedLimiter) Observe(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get(headerRateRemaining))
+	if err != nil || remaining > 0 {
+		return
+	}
+
+	wait := retryAfter(header)
+	if wait <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sharedLimiterTimeout)
+	defer cancel()
+
+	_ = limiter.backend.Block(ctx, limiter.key, time.Now().Add(wait))
+}
@@ -0,0 +1,145 @@
+package moysklad
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// NewFileLimitBackend возвращает [SharedLimitBackend], хранящий состояние ограничителя в файлах каталога dir.
+//
+// Доступ к состоянию согласуется файловыми блокировками, поэтому реализация подходит
+// для нескольких процессов на одной машине (или с общим локальным каталогом).
+func NewFileLimitBackend(dir string) (SharedLimitBackend, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &fileLimitBackend{dir: dir}, nil
+}
+
+type fileLimitBackend struct {
+	dir string
+}
+
+// fileLimitState состояние ограничителя по одному ключу.
+type fileLimitState struct {
+	Leases       map[string]int64 `json:"leases"`       // Занятые слоты и моменты их истечения (unix nano)
+	Requests     []int64          `json:"requests"`     // Моменты запросов в пределах текущего периода (unix nano)
+	BlockedUntil int64            `json:"blockedUntil"` // Момент, до которого запросы запрещены (unix nano)
+}
+
+const (
+	fileLimitPollInterval = 50 * time.Millisecond // Интервал повторной попытки при отсутствии свободного слота параллельного запроса
+	fileLockPollInterval  = 5 * time.Millisecond  // Интервал повторной попытки захвата файловой блокировки
+)
+
+func (backend *fileLimitBackend) Reserve(ctx context.Context, key string, limits SharedLimits) (string, time.Duration, error) {
+	var (
+		lease string
+		wait  time.Duration
+	)
+
+	err := backend.update(ctx, key, func(state *fileLimitState, now time.Time) {
+		state.expire(now, limits.Window)
+
+		switch {
+		case state.BlockedUntil > now.UnixNano():
+			wait = time.Duration(state.BlockedUntil - now.UnixNano())
+		case len(state.Leases) >= max(limits.Parallel, 1):
+			wait = fileLimitPollInterval
+		case len(state.Requests) >= max(limits.Requests, 1):
+			wait = time.Duration(state.Requests[0]+int64(limits.Window)-now.UnixNano()) + time.Millisecond
+		default:
+			lease = newLeaseID()
+			ttl := limits.LeaseTTL
+			if ttl <= 0 {
+				ttl = time.Minute
+			}
+			state.Leases[lease] = now.Add(ttl).UnixNano()
+			state.Requests = append(state.Requests, now.UnixNano())
+		}
+	})
+
+	return lease, wait, err
+}
+
+func (backend *fileLimitBackend) Release(ctx context.Context, key, lease string) error {
+	return backend.update(ctx, key, func(state *fileLimitState, _ time.Time) {
+		delete(state.Leases, lease)
+	})
+}
+
+func (backend *fileLimitBackend) Block(ctx context.Context, key string, until time.Time) error {
+	return backend.update(ctx, key, func(state *fileLimitState, _ time.Time) {
+		state.BlockedUntil = max(state.BlockedUntil, until.UnixNano())
+	})
+}
+
+// update считывает состояние по ключу key под файловой блокировкой, применяет к нему fn и сохраняет.
+func (backend *fileLimitBackend) update(ctx context.Context, key string, fn func(state *fileLimitState, now time.Time)) error {
+	path := filepath.Join(backend.dir, url.PathEscape(key)+".json")
+
+	unlock, err := lockFile(ctx, path+".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	state := &fileLimitState{}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return err
+	default:
+		// повреждённое состояние сбрасывается
+		_ = json.Unmarshal(data, state)
+	}
+
+	if state.Leases == nil {
+		state.Leases = make(map[string]int64)
+	}
+
+	fn(state, time.Now())
+
+	if data, err = json.Marshal(state); err != nil {
+		return err
+	}
+
+	// запись через временный файл, чтобы не оставить состояние частично записанным
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// expire удаляет истёкшие слоты и запросы за пределами периода window.
+func (state *fileLimitState) expire(now time.Time, window time.Duration) {
+	for lease, expiresAt := range state.Leases {
+		if expiresAt <= now.UnixNano() {
+			delete(state.Leases, lease)
+		}
+	}
+
+	since := now.Add(-window).UnixNano()
+	i := 0
+	for i < len(state.Requests) && state.Requests[i] <= since {
+		i++
+	}
+	state.Requests = state.Requests[i:]
+}
+
+func newLeaseID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,39 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// fileLockStaleAfter время, по истечении которого файл блокировки считается оставленным завершившимся процессом.
+const fileLockStaleAfter = 10 * time.Second
+
+// lockFile захватывает эксклюзивную блокировку, создавая файл path.
+// Ожидание блокировки прерывается при отмене контекста.
+func lockFile(ctx context.Context, path string) (func(), error) {
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			file.Close()
+			return func() { _ = os.Remove(path) }, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > fileLockStaleAfter {
+			_ = os.Remove(path)
+			continue
+		}
+
+		if err = sleepContext(ctx, fileLockPollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -21,6 +21,8 @@ type State struct {
 	Meta       *Meta     `json:"meta,omitempty"`       // Метаданные Статуса
 	Name       *string   `json:"name,omitempty"`       // Наименование Статуса
 	StateType  StateType `json:"stateType,omitempty"`  // Тип Статуса
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
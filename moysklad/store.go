@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -35,6 +36,8 @@ type Store struct {
 	Updated      *Timestamp       `json:"updated,omitempty"`      // Момент последнего обновления Склада
 	Zones        *MetaArray[Zone] `json:"zones,omitempty"`        // Зоны склада
 	Attributes   Slice[Attribute] `json:"attributes,omitempty"`   // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -275,6 +278,8 @@ type Slot struct {
 	Name         *string    `json:"name,omitempty"`         // Наименование Ячейки
 	Updated      *Timestamp `json:"updated,omitempty"`      // Момент последнего обновления Ячейки
 	Zone         *Zone      `json:"zone,omitempty"`         // Зона ячейки
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -375,6 +380,8 @@ type Zone struct {
 	Meta         *Meta      `json:"meta,omitempty"`         // Метаданные Зоны
 	Name         *string    `json:"name,omitempty"`         // Наименование Зоны
 	Updated      *Timestamp `json:"updated,omitempty"`      // Момент последнего обновления Зоны
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -447,6 +454,11 @@ type StoreService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Store], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех складов постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Store, error]
+
 	// Create выполняет запрос на создание склада.
 	// Обязательные поля для заполнения:
 	//	- name (Наименования склада)
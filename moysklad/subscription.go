@@ -18,6 +18,8 @@ type Subscription struct {
 	Tariff                        Tariff `json:"tariff,omitempty"`                        // Действующий тариф Аккаунта
 	SubscriptionEndDate           int64  `json:"subscriptionEndDate,omitempty"`           // Дата (в миллисекундах) окончания действия текущего тарифа, если тариф отличается от “Пробный” и “Бесплатный”
 	IsSubscriptionChangeAvailable bool   `json:"isSubscriptionChangeAvailable,omitempty"` // Доступность изменения подписки
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetSubscriptionEndDateAsTime возвращает дату окончания действия текущего тарифа, если тариф отличается от “Пробный” и “Бесплатный”.
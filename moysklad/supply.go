@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -38,7 +39,7 @@ type Supply struct {
 	Payments            Slice[Payment]             `json:"payments,omitempty"`            // Массив ссылок на связанные платежи
 	Agent               *Agent                     `json:"agent,omitempty"`               // Метаданные контрагента
 	IncomingNumber      *string                    `json:"incomingNumber,omitempty"`      // Входящий номер
-	PayedSum            *float64                   `json:"payedSum,omitempty"`            // Сумма входящих платежей по Приемке
+	PayedSum            *Decimal                   `json:"payedSum,omitempty"`            // Сумма входящих платежей по Приемке
 	Positions           *MetaArray[SupplyPosition] `json:"positions,omitempty"`           // Метаданные позиций Приемки
 	Printed             *bool                      `json:"printed,omitempty"`             // Напечатан ли документ
 	Project             *NullValue[Project]        `json:"project,omitempty"`             // Метаданные проекта
@@ -47,17 +48,19 @@ type Supply struct {
 	Shared              *bool                      `json:"shared,omitempty"`              // Общий доступ
 	State               *NullValue[State]          `json:"state,omitempty"`               // Метаданные статуса Приемки
 	Store               *Store                     `json:"store,omitempty"`               // Метаданные склада
-	Sum                 *float64                   `json:"sum,omitempty"`                 // Сумма Приемки в копейках
+	Sum                 *Decimal                   `json:"sum,omitempty"`                 // Сумма Приемки в копейках
 	SyncID              *string                    `json:"syncId,omitempty"`              // ID синхронизации
 	Updated             *Timestamp                 `json:"updated,omitempty"`             // Момент последнего обновления Приемки
 	VatEnabled          *bool                      `json:"vatEnabled,omitempty"`          // Учитывается ли НДС
 	VatIncluded         *bool                      `json:"vatIncluded,omitempty"`         // Включен ли НДС в цену
-	VatSum              *float64                   `json:"vatSum,omitempty"`              // Сумма НДС
+	VatSum              *Decimal                   `json:"vatSum,omitempty"`              // Сумма НДС
 	PurchaseOrder       *PurchaseOrder             `json:"purchaseOrder,omitempty"`       // Ссылка на связанный заказ поставщику
 	FactureIn           *FactureIn                 `json:"factureIn,omitempty"`           // Ссылка на Счет-фактуру полученный, с которым связана эта Приемка
 	InvoicesIn          Slice[InvoiceIn]           `json:"invoicesIn,omitempty"`          // Массив ссылок на связанные счета поставщиков
 	AccountID           *string                    `json:"accountId,omitempty"`           // ID учётной записи
 	Attributes          Slice[Attribute]           `json:"attributes,omitempty"`          // Список метаданных доп. полей
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -201,7 +204,7 @@ func (supply Supply) GetIncomingNumber() string {
 }
 
 // GetPayedSum возвращает Сумму входящих платежей по Приемке.
-func (supply Supply) GetPayedSum() float64 {
+func (supply Supply) GetPayedSum() Decimal {
 	return Deref(supply.PayedSum)
 }
 
@@ -246,7 +249,7 @@ func (supply Supply) GetStore() Store {
 }
 
 // GetSum возвращает Сумму Приемки в копейках.
-func (supply Supply) GetSum() float64 {
+func (supply Supply) GetSum() Decimal {
 	return Deref(supply.Sum)
 }
 
@@ -271,7 +274,7 @@ func (supply Supply) GetVatIncluded() bool {
 }
 
 // GetVatSum возвращает Сумму НДС.
-func (supply Supply) GetVatSum() float64 {
+func (supply Supply) GetVatSum() Decimal {
 	return Deref(supply.VatSum)
 }
 
@@ -573,22 +576,24 @@ func (supply *Supply) Delete(ctx context.Context, client *Client) (bool, *resty.
 //
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/documents/#dokumenty-priemka-priemki-pozicii-priemki
 type SupplyPosition struct {
-	Quantity      *float64            `json:"quantity,omitempty"`      // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
+	Quantity      *Decimal            `json:"quantity,omitempty"`      // Количество товаров/услуг данного вида в позиции. Если позиция - товар, у которого включен учет по серийным номерам, то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
 	Pack          *Pack               `json:"pack,omitempty"`          // Упаковка Товара
 	Country       *Country            `json:"country,omitempty"`       // Метаданные Страны
 	Discount      *float64            `json:"discount,omitempty"`      // Процент скидки или наценки. Наценка указывается отрицательным числом, т.е. -10 создаст наценку в 10%
 	AccountID     *string             `json:"accountId,omitempty"`     // ID учётной записи
 	ID            *string             `json:"id,omitempty"`            // ID позиции
 	Assortment    *AssortmentPosition `json:"assortment,omitempty"`    // Метаданные товара/услуги/серии/модификации, которую представляет собой позиция
-	Price         *float64            `json:"price,omitempty"`         // Цена товара/услуги в копейках
+	Price         *Decimal            `json:"price,omitempty"`         // Цена товара/услуги в копейках
 	GTD           *GTD                `json:"gtd,omitempty"`           // ГТД
 	Slot          *Slot               `json:"slot,omitempty"`          // Ячейка на складе
 	Stock         *Stock              `json:"stock,omitempty"`         // Остатки и себестоимость позиции (указывается при наличии параметра запроса `fields=stock`)
 	VatEnabled    *bool               `json:"vatEnabled,omitempty"`    // Включен ли НДС для позиции. С помощью этого флага для позиции можно выставлять НДС = 0 или НДС = "без НДС". (vat = 0, vatEnabled = false) -> vat = "без НДС", (vat = 0, vatEnabled = true) -> vat = 0%.
-	Overhead      *float64            `json:"overhead,omitempty"`      // Накладные расходы. Если Позиции Приемки не заданы, то накладные расходы нельзя задать.
+	Overhead      *Decimal            `json:"overhead,omitempty"`      // Накладные расходы. Если Позиции Приемки не заданы, то накладные расходы нельзя задать.
 	Vat           *int                `json:"vat,omitempty"`           // НДС, которым облагается текущая позиция
 	TrackingCodes Slice[TrackingCode] `json:"trackingCodes,omitempty"` // Коды маркировки товаров и транспортных упаковок
 	Things        Slice[string]       `json:"things,omitempty"`        // Серийные номера. Значение данного атрибута игнорируется, если товар позиции не находится на серийном учете. В ином случае количество товаров в позиции будет равно количеству серийных номеров, переданных в значении атрибута.
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -634,7 +639,7 @@ func (supplyPosition SupplyPosition) GetPack() Pack {
 }
 
 // GetPrice возвращает Цену товара/услуги в копейках.
-func (supplyPosition SupplyPosition) GetPrice() float64 {
+func (supplyPosition SupplyPosition) GetPrice() Decimal {
 	return Deref(supplyPosition.Price)
 }
 
@@ -642,7 +647,7 @@ func (supplyPosition SupplyPosition) GetPrice() float64 {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (supplyPosition SupplyPosition) GetQuantity() float64 {
+func (supplyPosition SupplyPosition) GetQuantity() Decimal {
 	return Deref(supplyPosition.Quantity)
 }
 
@@ -665,7 +670,7 @@ func (supplyPosition SupplyPosition) GetTrackingCodes() Slice[TrackingCode] {
 }
 
 // GetOverhead возвращает Накладные расходы.
-func (supplyPosition SupplyPosition) GetOverhead() float64 {
+func (supplyPosition SupplyPosition) GetOverhead() Decimal {
 	return Deref(supplyPosition.Overhead)
 }
 
@@ -729,7 +734,7 @@ func (supplyPosition *SupplyPosition) SetPack(pack *Pack) *SupplyPosition {
 }
 
 // SetPrice устанавливает Цену товара/услуги в копейках.
-func (supplyPosition *SupplyPosition) SetPrice(price float64) *SupplyPosition {
+func (supplyPosition *SupplyPosition) SetPrice(price Decimal) *SupplyPosition {
 	supplyPosition.Price = &price
 	return supplyPosition
 }
@@ -738,7 +743,7 @@ func (supplyPosition *SupplyPosition) SetPrice(price float64) *SupplyPosition {
 //
 // Если позиция - товар, у которого включен учет по серийным номерам,
 // то значение в этом поле всегда будет равно количеству серийных номеров для данной позиции в документе.
-func (supplyPosition *SupplyPosition) SetQuantity(quantity float64) *SupplyPosition {
+func (supplyPosition *SupplyPosition) SetQuantity(quantity Decimal) *SupplyPosition {
 	supplyPosition.Quantity = &quantity
 	return supplyPosition
 }
@@ -804,6 +809,11 @@ type SupplyService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Supply], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех приемок постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Supply, error]
+
 	// Create выполняет запрос на создание приемки.
 	// Обязательные поля для заполнения:
 	//	- organization (Ссылка на ваше юрлицо)
@@ -869,6 +879,11 @@ type SupplyService interface {
 
 	GetPositionListAll(ctx context.Context, id string, params ...func(*Params)) (*Slice[SupplyPosition], *resty.Response, error)
 
+	// GetPositionListSeq возвращает итератор для получения всех позиций документа постранично по мере обхода.
+	// Принимает контекст, ID документа и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetPositionListSeq(ctx context.Context, id string, params ...func(*Params)) iter.Seq2[*SupplyPosition, error]
+
 	// GetPositionByID выполняет запрос на получение отдельной позиции документа по ID.
 	// Принимает контекст, ID документа, ID позиции и опционально объект параметров запроса Params.
 	// Возвращает найденную позицию.
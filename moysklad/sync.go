@@ -0,0 +1,231 @@
+package moysklad
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"slices"
+	"time"
+)
+
+// SyncEntity описывает сущность, изменения которой можно получать с помощью [Syncer].
+type SyncEntity interface {
+	GetID() string
+	GetUpdated() time.Time
+}
+
+// SyncDeletedEntity описывает документ, перемещение которого в корзину можно отслеживать с помощью [Syncer]
+// (см. SyncOptions.TrackDeleted).
+type SyncDeletedEntity interface {
+	SyncEntity
+	GetDeleted() time.Time
+}
+
+// SyncSource описывает сервис, предоставляющий список сущностей T (например, [ProductService]).
+type SyncSource[T any] interface {
+	GetList(ctx context.Context, params ...func(*Params)) (*List[T], *resty.Response, error)
+}
+
+// SyncCursor позиция в списке объектов, упорядоченном по моменту последнего обновления
+// (для удалённых документов – по моменту удаления).
+type SyncCursor struct {
+	// Момент последнего обновления (удаления) последнего обработанного объекта с точностью до секунды.
+	Updated time.Time `json:"updated"`
+
+	// ID обработанных объектов, момент последнего обновления (удаления) которых приходится на секунду Updated.
+	// Позволяют не обрабатывать повторно объекты на границе, так как фильтр включает момент Updated.
+	IDs []string `json:"ids,omitempty"`
+}
+
+// advance сдвигает позицию на объект с ID id и моментом последнего обновления updated.
+func (cursor *SyncCursor) advance(id string, updated time.Time) {
+	updated = updated.Truncate(time.Second)
+
+	if updated.After(cursor.Updated) {
+		cursor.Updated = updated
+		cursor.IDs = cursor.IDs[:0:0]
+	}
+
+	cursor.IDs = append(cursor.IDs, id)
+}
+
+// seen возвращает «true», если объект с ID id и моментом последнего обновления updated уже обработан.
+func (cursor *SyncCursor) seen(id string, updated time.Time) bool {
+	updated = updated.Truncate(time.Second)
+	return updated.Before(cursor.Updated) || (updated.Equal(cursor.Updated) && slices.Contains(cursor.IDs, id))
+}
+
+// Checkpoint контрольная точка синхронизации.
+type Checkpoint struct {
+	Changes   SyncCursor `json:"changes"`   // Позиция в списке изменённых объектов
+	Deletions SyncCursor `json:"deletions"` // Позиция в списке удалённых документов
+}
+
+// CheckpointStore описывает хранилище контрольных точек синхронизации.
+//
+// Из коробки доступна реализация на основе файлов [NewFileCheckpointStore].
+type CheckpointStore interface {
+	// LoadCheckpoint возвращает контрольную точку по ключу key или nil, если она не сохранялась.
+	LoadCheckpoint(ctx context.Context, key string) (*Checkpoint, error)
+
+	// SaveCheckpoint сохраняет контрольную точку checkpoint по ключу key.
+	SaveCheckpoint(ctx context.Context, key string, checkpoint *Checkpoint) error
+}
+
+// SyncChange изменение объекта, полученное при синхронизации.
+type SyncChange[T any] struct {
+	Entity  *T   // Объект
+	Deleted bool // Объект удалён (перемещён в корзину)
+}
+
+// SyncOptions параметры синхронизации.
+type SyncOptions struct {
+	// Дополнительные параметры запроса (например, фильтры), применяемые к каждому запросу списка.
+	Params []func(*Params)
+
+	// Количество объектов на странице. По умолчанию [MaxPositions].
+	PageSize int
+
+	// Устанавливает флаг, который включает получение документов, перемещённых в корзину (isDeleted=true).
+	// Удалённые документы запрашиваются в порядке момента удаления (поле deleted).
+	// Применимо только к документам ([SyncDeletedEntity]): справочники удаляются безвозвратно и не возвращаются в списке.
+	TrackDeleted bool
+}
+
+// Syncer выполняет инкрементальную синхронизацию объектов T по полю updated.
+//
+// При каждом вызове [Syncer.Sync] запрашиваются только объекты, изменённые с момента,
+// сохранённого в контрольной точке, в порядке возрастания момента последнего обновления.
+// Страницы запрашиваются от текущей позиции, а не по смещению, поэтому изменение объектов
+// во время синхронизации не приводит к их пропуску.
+//
+// # Пример:
+//
+//	store, err := moysklad.NewFileCheckpointStore("/var/lib/moysklad")
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	syncer := moysklad.NewSyncer[moysklad.Product](client.Entity().Product(), store, "products", moysklad.SyncOptions{})
+//
+//	err = syncer.Sync(ctx, func(ctx context.Context, change moysklad.SyncChange[moysklad.Product]) error {
+//		return warehouse.Upsert(ctx, change.Entity)
+//	})
+type Syncer[T SyncEntity] struct {
+	source  SyncSource[T]
+	store   CheckpointStore
+	key     string
+	options SyncOptions
+}
+
+// NewSyncer возвращает [Syncer] для сервиса source.
+//
+// Контрольные точки сохраняются в хранилище store по ключу key,
+// который должен быть уникален для каждого синхронизируемого списка.
+func NewSyncer[T SyncEntity](source SyncSource[T], store CheckpointStore, key string, options SyncOptions) *Syncer[T] {
+	if options.PageSize <= 0 {
+		options.PageSize = MaxPositions
+	}
+	return &Syncer[T]{source: source, store: store, key: key, options: options}
+}
+
+// Sync получает объекты, изменённые с момента предыдущей синхронизации, и передаёт их в handler
+// в порядке возрастания момента последнего обновления.
+//
+// Контрольная точка сохраняется после каждой страницы и при ошибке handler,
+// поэтому при повторном вызове обработка продолжается с первого необработанного объекта.
+func (syncer *Syncer[T]) Sync(ctx context.Context, handler func(ctx context.Context, change SyncChange[T]) error) error {
+	checkpoint, err := syncer.store.LoadCheckpoint(ctx, syncer.key)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{}
+	}
+
+	if syncer.options.TrackDeleted {
+		if _, ok := any(*new(T)).(SyncDeletedEntity); !ok {
+			return fmt.Errorf("moysklad: %T does not support TrackDeleted: GetDeleted method is missing", *new(T))
+		}
+	}
+
+	if err = syncer.pass(ctx, checkpoint, &checkpoint.Changes, false, handler); err != nil {
+		return err
+	}
+
+	if syncer.options.TrackDeleted {
+		return syncer.pass(ctx, checkpoint, &checkpoint.Deletions, true, handler)
+	}
+
+	return nil
+}
+
+// pass обходит список объектов от позиции cursor.
+//
+// Изменённые объекты упорядочиваются по полю updated, удалённые документы – по полю deleted,
+// так как перемещение в корзину может не изменять момент последнего обновления.
+func (syncer *Syncer[T]) pass(ctx context.Context, checkpoint *Checkpoint, cursor *SyncCursor, deleted bool,
+	handler func(ctx context.Context, change SyncChange[T]) error) error {
+
+	// смещение от позиции cursor: увеличивается, если страница целиком состоит из обработанных объектов
+	// (например, когда более PageSize объектов обновлены в одну секунду)
+	var offset int
+
+	field, moment := "updated", T.GetUpdated
+	if deleted {
+		field, moment = "deleted", func(entity T) time.Time {
+			return any(entity).(SyncDeletedEntity).GetDeleted()
+		}
+	}
+
+	for {
+		params := []func(*Params){WithOrderAsc(field)}
+		params = append(params, syncer.options.Params...)
+
+		if !cursor.Updated.IsZero() {
+			params = append(params, WithFilterMoment(field, FilterGreaterOrEquals, cursor.Updated))
+		}
+
+		if deleted {
+			params = append(params, WithFilterDeleted(true))
+		}
+
+		params = append(params, WithLimit(syncer.options.PageSize), WithOffset(offset))
+
+		list, _, err := syncer.source.GetList(ctx, params...)
+		if err != nil {
+			return err
+		}
+
+		var processed int
+		for _, entity := range list.Rows {
+			if entity == nil || cursor.seen((*entity).GetID(), moment(*entity)) {
+				continue
+			}
+
+			if err = handler(ctx, SyncChange[T]{Entity: entity, Deleted: deleted}); err != nil {
+				if processed > 0 {
+					_ = syncer.store.SaveCheckpoint(ctx, syncer.key, checkpoint)
+				}
+				return err
+			}
+
+			cursor.advance((*entity).GetID(), moment(*entity))
+			processed++
+		}
+
+		if processed > 0 {
+			if err = syncer.store.SaveCheckpoint(ctx, syncer.key, checkpoint); err != nil {
+				return err
+			}
+			offset = 0
+		} else {
+			offset += len(list.Rows)
+		}
+
+		if len(list.Rows) < syncer.options.PageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package moysklad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// NewFileCheckpointStore возвращает [CheckpointStore], хранящий контрольные точки синхронизации
+// в файлах JSON каталога dir.
+func NewFileCheckpointStore(dir string) (CheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &fileCheckpointStore{dir: dir}, nil
+}
+
+type fileCheckpointStore struct {
+	dir string
+}
+
+func (store *fileCheckpointStore) LoadCheckpoint(_ context.Context, key string) (*Checkpoint, error) {
+	data, err := os.ReadFile(store.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &Checkpoint{}
+	if err = json.Unmarshal(data, checkpoint); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+func (store *fileCheckpointStore) SaveCheckpoint(_ context.Context, key string, checkpoint *Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	// запись через временный файл, чтобы не оставить контрольную точку частично записанной
+	path := store.path(key)
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (store *fileCheckpointStore) path(key string) string {
+	return filepath.Join(store.dir, url.PathEscape(key)+".checkpoint.json")
+}
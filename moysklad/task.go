@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 	"time"
 )
 
@@ -34,6 +35,8 @@ type Task struct {
 	Notes             *MetaArray[TaskNote] `json:"notes,omitempty"`             // Метаданные комментариев к задаче
 	Operation         *TaskOperation       `json:"operation,omitempty"`         // Метаданные Документа, связанного с задачей. Задача может быть привязана либо к контрагенту, либо к юрлицу, либо к документу
 	Updated           *Timestamp           `json:"updated,omitempty"`           // Момент последнего обновления Задачи
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // TaskOperationConverter описывает метод, который возвращает объект [TaskOperation].
@@ -254,6 +257,8 @@ type TaskNote struct {
 	AuthorApplication *Meta      `json:"authorApplication,omitempty"` // Метаданные Приложения, создавшего комментарий
 	Moment            *Timestamp `json:"moment,omitempty"`            // Момент создания комментария
 	Description       *string    `json:"description,omitempty"`       // Текст комментария
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAuthor возвращает Метаданные Сотрудника, создавшего комментарий (администратор аккаунта, если автор - приложение).
@@ -839,6 +844,11 @@ type TaskService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Task], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех задач постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Task, error]
+
 	// Create выполняет запрос на создание задачи.
 	// Создать новую задачу. Для создания новых задач необходима активная тарифная опция CRM.
 	// Обязательные поля для заполнения:
@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -25,6 +26,8 @@ type TaxRate struct {
 	Owner     *Employee  `json:"owner,omitempty"`     // Метаданные владельца (Сотрудника)
 	Shared    *bool      `json:"shared,omitempty"`    // Общий доступ
 	Updated   *Timestamp `json:"updated,omitempty"`   // Момент последнего обновления налоговой ставки
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -160,6 +163,11 @@ type TaxRateService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[TaxRate], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех налоговых ставок постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*TaxRate, error]
+
 	// Create выполняет запрос на создание налоговой ставки.
 	// Обязательные поля для заполнения:
 	//	- rate (Значение налоговой ставки)
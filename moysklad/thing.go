@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Thing Серийный номер
@@ -16,6 +17,8 @@ type Thing struct {
 	ID          *string `json:"id,omitempty"`          // ID Серийного номера
 	Meta        *Meta   `json:"meta,omitempty"`        // Метаданные о Серийном номере
 	Name        *string `json:"name,omitempty"`        // Наименование Серийного номера
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -83,6 +86,11 @@ type ThingService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Thing], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех серийных номеров постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Thing, error]
+
 	// GetByID выполняет запрос на получение отдельного серийного номера по ID.
 	// Принимает контекст, ID серийного номера и опционально объект параметров запроса Params.
 	// Возвращает найденный серийный номер.
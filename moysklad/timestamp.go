@@ -1,7 +1,9 @@
 package moysklad
 
 import (
+	"bytes"
 	"encoding/json"
+	"reflect"
 	"time"
 )
 
@@ -12,8 +14,28 @@ import (
 // [Документация МойСклад]: https://dev.moysklad.ru/doc/api/remap/1.2/#mojsklad-json-api-obschie-swedeniq-format-daty-i-wremeni
 const TimestampFormat = "2006-01-02 15:04:05.000"
 
+// DefaultLocation часовой пояс учётной записи по умолчанию (Europe/Moscow).
+//
+// API возвращает и принимает дату и время в часовом поясе учётной записи без указания смещения.
+// Если часовой пояс учётной записи отличается, его следует указать в поле Location конфигурации клиента [Config].
+var DefaultLocation = loadDefaultLocation()
+
+func loadDefaultLocation() *time.Location {
+	location, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		// база часовых поясов недоступна; смещение Москвы не меняется с 2014 года
+		return time.FixedZone("MSK", 3*60*60)
+	}
+	return location
+}
+
 // Timestamp represents a time that can be unmarshalled from a JSON string
 // formatted as either an TimestampFormat.
+//
+// При декодировании время считается указанным в часовом поясе [DefaultLocation],
+// клиент переводит его в часовой пояс учётной записи (см. Config.Location).
+// При кодировании время передаётся с миллисекундами в часовом поясе [DefaultLocation],
+// в запросах клиента с другим часовым поясом учётной записи – в часовом поясе учётной записи.
 type Timestamp time.Time
 
 // NewTimestamp принимает [time.Time] и возвращает [Timestamp].
@@ -34,12 +56,135 @@ func (timestamp Timestamp) Time() time.Time {
 
 // MarshalJSON реализует интерфейс [json.Marshaler].
 func (timestamp Timestamp) MarshalJSON() ([]byte, error) {
-	return json.Marshal((time.Time)(timestamp).Format("2006-01-02 15:04:05"))
+	return json.Marshal(timestamp.Time().In(DefaultLocation).Format(TimestampFormat))
 }
 
 // UnmarshalJSON реализует интерфейс [json.Unmarshaler].
-func (timestamp *Timestamp) UnmarshalJSON(data []byte) (err error) {
-	t, err := time.Parse(`"`+TimestampFormat+`"`, string(data))
+//
+// Принимает время как с миллисекундами, так и без них.
+func (timestamp *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	// дробная часть секунд разбирается, даже если её нет в формате
+	t, err := time.ParseInLocation(time.DateTime, s, DefaultLocation)
+	if err != nil {
+		return err
+	}
 	*timestamp = Timestamp(t)
-	return
+	return nil
+}
+
+// inLocation возвращает время с теми же показаниями часов, что и у timestamp в [DefaultLocation],
+// но в часовом поясе location.
+func (timestamp Timestamp) inLocation(location *time.Location) Timestamp {
+	t := timestamp.Time().In(DefaultLocation)
+	return Timestamp(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), location))
+}
+
+// fromLocation возвращает время с теми же показаниями часов, что и у timestamp в часовом поясе location,
+// но в [DefaultLocation]. Обратно [Timestamp.inLocation].
+func (timestamp Timestamp) fromLocation(location *time.Location) Timestamp {
+	t := timestamp.Time().In(location)
+	return Timestamp(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), DefaultLocation))
+}
+
+var timestampType = reflect.TypeFor[Timestamp]()
+
+// walkTimestamps вызывает fn для каждого значения [Timestamp], вложенного в value.
+//
+// Значения, которые нельзя изменить (элементы map, значения в интерфейсах не по указателю), пропускаются.
+func walkTimestamps(value reflect.Value, fn func(*Timestamp)) {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !value.IsNil() {
+			walkTimestamps(value.Elem(), fn)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := range value.Len() {
+			walkTimestamps(value.Index(i), fn)
+		}
+
+	case reflect.Struct:
+		if value.Type() == timestampType {
+			if value.CanAddr() {
+				fn(value.Addr().Interface().(*Timestamp))
+			}
+			return
+		}
+
+		for i := range value.NumField() {
+			if value.Type().Field(i).IsExported() {
+				walkTimestamps(value.Field(i), fn)
+			}
+		}
+	}
+}
+
+// timestampsFrom возвращает копию value, в которой показания часов значений [Timestamp] в часовом поясе location
+// перенесены в [DefaultLocation] (см. [Timestamp.fromLocation]), чтобы они были переданы в часовом поясе location.
+//
+// Указатели, срезы и структуры копируются, поэтому value не изменяется и может одновременно использоваться
+// в других горутинах. Элементы map, неэкспортируемые поля и срезы простых типов не копируются.
+func timestampsFrom(value reflect.Value, location *time.Location) reflect.Value {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type().Elem())
+		copied.Elem().Set(timestampsFrom(value.Elem(), location))
+		return copied
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(timestampsFrom(value.Elem(), location))
+		return copied
+
+	case reflect.Slice, reflect.Array:
+		if (value.Kind() == reflect.Slice && value.IsNil()) || isBasicKind(value.Type().Elem().Kind()) {
+			return value
+		}
+
+		var copied reflect.Value
+		if value.Kind() == reflect.Slice {
+			copied = reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		} else {
+			copied = reflect.New(value.Type()).Elem()
+		}
+		for i := range value.Len() {
+			copied.Index(i).Set(timestampsFrom(value.Index(i), location))
+		}
+		return copied
+
+	case reflect.Struct:
+		if value.Type() == timestampType {
+			return reflect.ValueOf(value.Interface().(Timestamp).fromLocation(location))
+		}
+
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := range value.NumField() {
+			if value.Type().Field(i).IsExported() {
+				copied.Field(i).Set(timestampsFrom(value.Field(i), location))
+			}
+		}
+		return copied
+	}
+	return value
+}
+
+// isBasicKind возвращает true для чисел, строк и логических значений.
+func isBasicKind(kind reflect.Kind) bool {
+	return kind >= reflect.Bool && kind <= reflect.Complex128 || kind == reflect.String
 }
@@ -0,0 +1,62 @@
+package moysklad_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+	"github.com/EnOane/go-moysklad/moysklad/moyskladtest"
+)
+
+func TestTimestampJSON(t *testing.T) {
+	yekaterinburg := time.FixedZone("YEKT", 5*60*60)
+	moment := time.Date(2024, time.March, 1, 12, 30, 15, 123_000_000, yekaterinburg)
+
+	encoded, err := json.Marshal(moysklad.NewTimestamp(moment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// значение передаётся в часовом поясе по умолчанию (Москва)
+	if want := `"2024-03-01 10:30:15.123"`; string(encoded) != want {
+		t.Errorf("json.Marshal = %s, want %s", encoded, want)
+	}
+
+	var decoded moysklad.Timestamp
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Time().Equal(moment) {
+		t.Errorf("round trip = %s, want %s", decoded, moment)
+	}
+}
+
+func TestTimestampClientLocation(t *testing.T) {
+	yekaterinburg := time.FixedZone("YEKT", 5*60*60)
+	moment := time.Date(2024, time.March, 1, 12, 30, 15, 123_000_000, yekaterinburg)
+
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{Location: yekaterinburg})
+
+	order := new(moysklad.CustomerOrder).SetMoment(moment)
+	created, _, err := client.Entity().CustomerOrder().Create(context.Background(), order)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// в запросе время указано в часовом поясе учётной записи, тело запроса не изменено
+	requests := server.Requests()
+	if body := string(requests[len(requests)-1].Body); !strings.Contains(body, `"moment":"2024-03-01 12:30:15.123"`) {
+		t.Errorf("request body = %s, want moment in account location", body)
+	}
+	if got := order.GetMoment(); got != moment {
+		t.Errorf("order moment changed to %s", got)
+	}
+
+	// в ответе время переводится из часового пояса учётной записи
+	if got := created.GetMoment(); !got.Equal(moment) || got.Location() != yekaterinburg {
+		t.Errorf("created moment = %s, want %s", got, moment)
+	}
+}
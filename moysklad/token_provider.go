@@ -0,0 +1,151 @@
+package moysklad
+
+import (
+	"context"
+	"errors"
+	"github.com/go-resty/resty/v2"
+	"net/http"
+	"sync"
+)
+
+// TokenProvider описывает источник токена доступа к API.
+//
+// Клиент запрашивает токен перед каждым запросом, поэтому реализация должна кэшировать токен
+// и быть безопасной для использования из нескольких горутин.
+//
+// Из коробки доступны реализации:
+//   - [NewCredentialsTokenProvider] – получает токен по логину и паролю (используется по умолчанию, если токен не указан)
+//   - [StaticToken]                 – возвращает заданный токен
+//   - [TokenProviderFunc]           – адаптер для функции (например, получения токена из хранилища секретов)
+//
+// Запрос, получивший ответ 401, повторяется с новым токеном, только если источник реализует [RefreshableTokenProvider].
+type TokenProvider interface {
+	// Token возвращает действующий токен.
+	Token(ctx context.Context) (string, error)
+}
+
+// RefreshableTokenProvider источник токена, который может выдать новый токен взамен отклонённого сервером.
+//
+// После ответа 401 клиент вызывает Invalidate и однократно повторяет запрос с новым токеном.
+// Повтор не расходует попытки [RetryPolicy].
+type RefreshableTokenProvider interface {
+	TokenProvider
+
+	// Invalidate сообщает, что токен token отклонён сервером (получен ответ 401).
+	// Следующий вызов Token должен вернуть новый токен.
+	Invalidate(token string)
+}
+
+// StaticToken возвращает [TokenProvider], который всегда возвращает токен token.
+func StaticToken(token string) TokenProvider {
+	return TokenProviderFunc(func(context.Context) (string, error) {
+		return token, nil
+	})
+}
+
+// TokenProviderFunc адаптер, позволяющий использовать функцию в качестве [TokenProvider].
+//
+// Функция вызывается перед каждым запросом. Запрос, получивший ответ 401, не повторяется.
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// Token реализует интерфейс [TokenProvider].
+func (fn TokenProviderFunc) Token(ctx context.Context) (string, error) {
+	return fn(ctx)
+}
+
+// NewCredentialsTokenProvider возвращает [RefreshableTokenProvider], который получает токен по логину username
+// и паролю password запросом к security/token через клиент client.
+//
+// Токен кэшируется и запрашивается повторно после получения ответа 401.
+func NewCredentialsTokenProvider(client *Client, username, password string) RefreshableTokenProvider {
+	return &credentialsTokenProvider{client: client, username: username, password: password}
+}
+
+type credentialsTokenProvider struct {
+	client   *Client
+	username string
+	password string
+	token    string
+	mu       sync.Mutex
+}
+
+func (provider *credentialsTokenProvider) Token(ctx context.Context) (string, error) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.token != "" {
+		return provider.token, nil
+	}
+
+	requestBuilder := NewRequestBuilder[Token](provider.client, EndpointToken)
+	requestBuilder.req.SetBasicAuth(provider.username, provider.password)
+
+	token, _, err := requestBuilder.Post(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if token == nil || token.AccessToken == "" {
+		return "", errors.New("moysklad: empty access token")
+	}
+
+	provider.token = token.AccessToken
+	return provider.token, nil
+}
+
+func (provider *credentialsTokenProvider) Invalidate(token string) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.token == token {
+		provider.token = ""
+	}
+}
+
+// SetTokenProvider устанавливает источник токена для последующих запросов клиента.
+func (client *Client) SetTokenProvider(provider TokenProvider) *Client {
+	client.tokenProvider.Store(&provider)
+	return client
+}
+
+// getTokenProvider возвращает источник токена клиента или nil, если он не установлен.
+func (client *Client) getTokenProvider() TokenProvider {
+	if provider := client.tokenProvider.Load(); provider != nil {
+		return *provider
+	}
+	return nil
+}
+
+// authorize устанавливает токен из [TokenProvider] клиента в запрос.
+//
+// Запросы с явно указанными логином и паролем (например, запрос получения токена) не изменяются.
+func (client *Client) authorize(ctx context.Context, request *resty.Request) error {
+	provider := client.getTokenProvider()
+	if provider == nil || request.UserInfo != nil {
+		return nil
+	}
+
+	token, err := provider.Token(ctx)
+	if err != nil {
+		return err
+	}
+
+	request.SetAuthToken(token)
+	return nil
+}
+
+// invalidateToken сообщает [RefreshableTokenProvider] клиента об отклонении токена запроса,
+// если получен ответ 401. Возвращает «true», если запрос следует повторить с новым токеном.
+func (client *Client) invalidateToken(request *resty.Request, resp *resty.Response) bool {
+	provider, ok := client.getTokenProvider().(RefreshableTokenProvider)
+	if !ok || request.UserInfo != nil || request.Token == "" {
+		return false
+	}
+
+	if resp == nil || resp.RawResponse == nil || resp.StatusCode() != http.StatusUnauthorized {
+		return false
+	}
+
+	provider.Invalidate(request.Token)
+	return true
+}
@@ -0,0 +1,72 @@
+package moysklad_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+	"github.com/EnOane/go-moysklad/moysklad/moyskladtest"
+)
+
+// rotatingToken возвращает устаревший токен до первого вызова Invalidate.
+type rotatingToken struct {
+	invalidated []string
+	mu          sync.Mutex
+}
+
+func (provider *rotatingToken) Token(context.Context) (string, error) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if len(provider.invalidated) == 0 {
+		return "stale", nil
+	}
+	return moyskladtest.DefaultToken, nil
+}
+
+func (provider *rotatingToken) Invalidate(token string) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	provider.invalidated = append(provider.invalidated, token)
+}
+
+func TestReauthorizeRefreshableToken(t *testing.T) {
+	server := moyskladtest.New(t)
+	provider := new(rotatingToken)
+
+	// повтор с новым токеном не расходует единственную попытку политики повторов
+	client := server.Client(moysklad.Config{
+		TokenProvider: provider,
+		RetryPolicy:   &moysklad.RetryPolicy{MaxAttempts: 1},
+	})
+
+	if _, _, err := client.Entity().Product().GetList(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(server.Requests()); got != 2 {
+		t.Errorf("server received %d requests, want 2", got)
+	}
+	if len(provider.invalidated) != 1 || provider.invalidated[0] != "stale" {
+		t.Errorf("invalidated tokens = %v, want [stale]", provider.invalidated)
+	}
+}
+
+func TestReauthorizeStaticToken(t *testing.T) {
+	server := moyskladtest.New(t)
+	client := server.Client(moysklad.Config{
+		TokenProvider: moysklad.StaticToken("stale"),
+		RetryPolicy:   moysklad.DefaultRetryPolicy(),
+	})
+
+	if _, _, err := client.Entity().Product().GetList(context.Background()); err == nil {
+		t.Fatal("request with a rejected static token returned no error")
+	}
+
+	// тот же токен повторно не отправляется
+	if got := len(server.Requests()); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
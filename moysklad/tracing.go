@@ -0,0 +1,150 @@
+package moysklad
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"strconv"
+	"strings"
+)
+
+const (
+	instrumentationName = "github.com/EnOane/go-moysklad" // Имя инструментирующей библиотеки для OpenTelemetry
+	remapPathPrefix     = "/api/remap/1.2/"               // Путь JSON API относительно адреса сервиса
+)
+
+// Атрибуты спанов OpenTelemetry.
+const (
+	attrEntity             = attribute.Key("moysklad.entity")
+	attrPageOffset         = attribute.Key("moysklad.page.offset")
+	attrChunkIndex         = attribute.Key("moysklad.chunk.index")
+	attrChunkSize          = attribute.Key("moysklad.chunk.size")
+	attrAttempt            = attribute.Key("moysklad.attempt")
+	attrErrorCodes         = attribute.Key("moysklad.error.codes")
+	attrRateLimit          = attribute.Key("moysklad.ratelimit.limit")
+	attrRateRemaining      = attribute.Key("moysklad.ratelimit.remaining")
+	attrHTTPRequestMethod  = attribute.Key("http.request.method")
+	attrHTTPResponseStatus = attribute.Key("http.response.status_code")
+	attrURLPath            = attribute.Key("url.path")
+)
+
+// startSpan начинает спан с именем name, дочерний по отношению к спану из контекста ctx.
+func (client *Client) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return client.tracer.Start(ctx, "moysklad "+name, trace.WithAttributes(attrs...))
+}
+
+// startRequestSpan начинает спан HTTP-запроса.
+func (client *Client) startRequestSpan(ctx context.Context, info *RequestInfo, attempt int) (context.Context, trace.Span) {
+	entity := entityFromURI(info.URI)
+
+	attrs := []attribute.KeyValue{
+		attrEntity.String(entity),
+		attrHTTPRequestMethod.String(info.Method),
+		attrURLPath.String(info.URI),
+		attrAttempt.Int(attempt),
+	}
+
+	if offset, err := strconv.Atoi(info.Params.Get("offset")); err == nil {
+		attrs = append(attrs, attrPageOffset.Int(offset))
+	}
+
+	return client.tracer.Start(ctx, info.Method+" "+entity,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// endRequestSpan завершает спан HTTP-запроса, добавляя сведения об ответе.
+func endRequestSpan(span trace.Span, resp *resty.Response, err error) {
+	if resp != nil && resp.RawResponse != nil {
+		span.SetAttributes(attrHTTPResponseStatus.Int(resp.StatusCode()))
+
+		if limit, err := strconv.Atoi(resp.Header().Get(headerRateLimit)); err == nil {
+			span.SetAttributes(attrRateLimit.Int(limit))
+		}
+
+		if remaining, err := strconv.Atoi(resp.Header().Get(headerRateRemaining)); err == nil {
+			span.SetAttributes(attrRateRemaining.Int(remaining))
+		}
+
+		if resp.IsError() {
+			if errorCodes := apiErrorCodes(resp.Body()); len(errorCodes) > 0 {
+				span.SetAttributes(attrErrorCodes.IntSlice(errorCodes))
+			}
+			span.SetStatus(codes.Error, resp.Status())
+		}
+	}
+
+	endSpan(span, err)
+}
+
+// endSpan завершает спан, отмечая ошибку err, если она есть.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// apiErrorCodes возвращает коды ошибок API из тела ответа.
+// Поддерживает как одиночный объект ошибок, так и массив (при массовых операциях).
+func apiErrorCodes(body []byte) []int {
+	var objects []ApiErrors
+
+	if err := json.Unmarshal(body, &objects); err != nil {
+		var single ApiErrors
+		if err = json.Unmarshal(body, &single); err != nil {
+			return nil
+		}
+		objects = append(objects, single)
+	}
+
+	var errorCodes []int
+	for _, object := range objects {
+		errorCodes = append(errorCodes, object.Codes()...)
+	}
+	return errorCodes
+}
+
+// entityFromURI возвращает тип сущности или отчёта по пути запроса.
+//
+// Например, для entity/customerorder/{id}/positions возвращает "customerorder",
+// для report/stock/all – "report/stock".
+func entityFromURI(uri string) string {
+	segments := uriSegments(uri)
+
+	switch {
+	case len(segments) > 1 && segments[0]+"/" == EndpointEntity:
+		return segments[1]
+	case len(segments) > 1 && !isUUID(segments[1]):
+		return segments[0] + "/" + segments[1]
+	default:
+		return segments[0]
+	}
+}
+
+// uriSegments возвращает сегменты пути uri относительно адреса JSON API без параметров запроса.
+func uriSegments(uri string) []string {
+	uri, _, _ = strings.Cut(uri, "?")
+
+	// абсолютные адреса (например, адрес статуса асинхронной задачи)
+	if _, path, ok := strings.Cut(uri, "://"); ok {
+		if _, rest, ok := strings.Cut(path, remapPathPrefix); ok {
+			uri = rest
+		} else {
+			// адрес без пути JSON API (например, прокси): путь после хоста
+			_, uri, _ = strings.Cut(path, "/")
+		}
+	}
+
+	return strings.Split(strings.Trim(uri, "/"), "/")
+}
+
+// isUUID возвращает true, если строка s имеет формат идентификатора объекта.
+func isUUID(s string) bool {
+	return len(s) == 36 && strings.Count(s, "-") == 4
+}
@@ -12,6 +12,8 @@ type TrackingCode struct {
 	Type             TrackingCodeType    `json:"type,omitempty"`               // Тип кода маркировки
 	TrackingCodes    Slice[TrackingCode] `json:"trackingCodes,omitempty"`      // Массив вложенных кодов маркировки. Может присутствовать, только если type имеет значения consumerpack или transportpack
 	TrackingCode1162 Slice[TrackingCode] `json:"trackingCodes_1162,omitempty"` // Массив вложенных кодов маркировки. Может присутствовать, только если type имеет значения consumerpack или transportpack
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetID возвращает ID кода маркировки.
@@ -0,0 +1,242 @@
+package moysklad
+
+//go:generate go run ./internal/unknowngen -src . -out unknown_fields_gen.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// unknownFields поля JSON объекта, не описанные в его структуре.
+//
+// Встраивается в структуры сущностей, документов и позиций, чтобы поля, добавленные в API
+// после выхода версии библиотеки, не терялись при изменении объекта, полученного от API.
+// Методы UnmarshalJSON и MarshalJSON таких структур генерируются командой go generate.
+//
+// Поля хранятся строкой, а не map, чтобы структуры оставались сравнимыми оператором ==.
+type unknownFields struct {
+	members string // члены объекта JSON через запятую без фигурных скобок, в порядке получения
+	keep    bool
+}
+
+// UnknownFields возвращает поля JSON, полученные от API, но не описанные в структуре объекта.
+func (unknown unknownFields) UnknownFields() map[string]json.RawMessage {
+	if unknown.members == "" {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	_ = json.Unmarshal([]byte("{"+unknown.members+"}"), &fields)
+	return fields
+}
+
+// KeepUnknownFields включает или отключает передачу неизвестных полей при сериализации объекта.
+//
+// По умолчанию неизвестные поля сохраняются при декодировании, но не передаются в запросах.
+// Чтобы включить передачу для всех объектов, полученных клиентом, используйте Config.PreserveUnknownFields.
+func (unknown *unknownFields) KeepUnknownFields(keep bool) {
+	unknown.keep = keep
+}
+
+// unmarshalUnknownFields декодирует объект JSON data в структуру по указателю v
+// и сохраняет в unknown поля, не описанные в структуре.
+//
+// Объект просматривается за один проход: значения известных полей декодируются в поля структуры
+// так же, как [json.Unmarshal], значения неизвестных копируются без декодирования.
+func unmarshalUnknownFields(data []byte, v any, unknown *unknownFields) error {
+	value := reflect.ValueOf(v).Elem()
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return nil
+	}
+	if token != json.Delim('{') {
+		return &json.UnmarshalTypeError{Value: tokenKind(token), Type: value.Type(), Offset: decoder.InputOffset()}
+	}
+
+	fields := jsonFields(value.Type())
+
+	var members strings.Builder
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return err
+		}
+		name := token.(string)
+
+		field, ok := fields[name]
+		if !ok {
+			field, ok = fields[strings.ToLower(name)]
+		}
+		if ok {
+			if err = decoder.Decode(fieldByIndex(value, field.index).Addr().Interface()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var raw json.RawMessage
+		if err = decoder.Decode(&raw); err != nil {
+			return err
+		}
+
+		if members.Len() > 0 {
+			members.WriteByte(',')
+		}
+		key, _ := json.Marshal(name)
+		members.Write(key)
+		members.WriteByte(':')
+		members.Write(raw)
+	}
+
+	if _, err = decoder.Token(); err != nil {
+		return err
+	}
+
+	unknown.members = members.String()
+	return nil
+}
+
+// tokenKind возвращает вид значения JSON, которое начинается с token, для [json.UnmarshalTypeError].
+func tokenKind(token json.Token) string {
+	switch token.(type) {
+	case json.Delim:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "bool"
+	}
+	return "number"
+}
+
+// fieldByIndex возвращает поле value по индексу index, создавая встроенные структуры по нулевому указателю.
+func fieldByIndex(value reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		value = value.Field(x)
+	}
+	return value
+}
+
+// marshalUnknownFields кодирует v и, если передача неизвестных полей включена, добавляет их в конец объекта.
+func marshalUnknownFields(v any, unknown unknownFields) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || !unknown.keep || unknown.members == "" {
+		return data, err
+	}
+
+	buf := make([]byte, 0, len(data)+len(unknown.members)+1)
+	buf = append(buf, data[:len(data)-1]...)
+	if len(data) > 2 {
+		buf = append(buf, ',')
+	}
+	buf = append(buf, unknown.members...)
+	return append(buf, '}'), nil
+}
+
+// jsonField поле JSON структуры.
+type jsonField struct {
+	index []int        // индекс поля для [reflect.Value.FieldByIndex]
+	typ   reflect.Type // тип поля
+}
+
+// jsonFieldsCache поля JSON структур по типу структуры.
+var jsonFieldsCache sync.Map
+
+// jsonFields возвращает поля JSON структуры typ по названию поля в нижнем регистре,
+// так как [json.Unmarshal] сопоставляет поля без учёта регистра, а также по названию из тега,
+// чтобы поля в точном написании находились без приведения к нижнему регистру.
+//
+// Как и в [json.Unmarshal], поле встроенной структуры не заменяет одноимённое поле внешней.
+func jsonFields(typ reflect.Type) map[string]jsonField {
+	if fields, ok := jsonFieldsCache.Load(typ); ok {
+		return fields.(map[string]jsonField)
+	}
+
+	fields := make(map[string]jsonField)
+	collectFields(typ, nil, fields)
+
+	jsonFieldsCache.Store(typ, fields)
+	return fields
+}
+
+func collectFields(typ reflect.Type, index []int, fields map[string]jsonField) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		fieldIndex := append(slices.Clip(index), i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		// поля встроенных структур без тега json продвигаются на уровень объекта
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				collectFields(fieldType, fieldIndex, fields)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		for _, key := range []string{name, strings.ToLower(name)} {
+			if existing, ok := fields[key]; !ok || len(existing.index) > len(fieldIndex) {
+				fields[key] = jsonField{index: fieldIndex, typ: field.Type}
+			}
+		}
+	}
+}
+
+// keepUnknownFields включает передачу неизвестных полей для всех объектов, вложенных в value.
+func keepUnknownFields(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !value.IsNil() {
+			keepUnknownFields(value.Elem())
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := range value.Len() {
+			keepUnknownFields(value.Index(i))
+		}
+
+	case reflect.Struct:
+		if value.CanAddr() {
+			if keeper, ok := value.Addr().Interface().(interface{ KeepUnknownFields(bool) }); ok {
+				keeper.KeepUnknownFields(true)
+			}
+		}
+
+		for i := range value.NumField() {
+			if value.Type().Field(i).IsExported() {
+				keepUnknownFields(value.Field(i))
+			}
+		}
+	}
+}
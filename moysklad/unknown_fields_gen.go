@@ -0,0 +1,1482 @@
+// Code generated by go run ./internal/unknowngen. DO NOT EDIT.
+
+package moysklad
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (accumulationDiscount *AccumulationDiscount) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, accumulationDiscount, &accumulationDiscount.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (accumulationDiscount AccumulationDiscount) MarshalJSON() ([]byte, error) {
+	type alias AccumulationDiscount
+	return marshalUnknownFields(alias(accumulationDiscount), accumulationDiscount.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (adminRole *AdminRole) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, adminRole, &adminRole.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (adminRole AdminRole) MarshalJSON() ([]byte, error) {
+	type alias AdminRole
+	return marshalUnknownFields(alias(adminRole), adminRole.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (agentAccount *AgentAccount) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, agentAccount, &agentAccount.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (agentAccount AgentAccount) MarshalJSON() ([]byte, error) {
+	type alias AgentAccount
+	return marshalUnknownFields(alias(agentAccount), agentAccount.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (application *Application) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, application, &application.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (application Application) MarshalJSON() ([]byte, error) {
+	type alias Application
+	return marshalUnknownFields(alias(application), application.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (assortmentSettings *AssortmentSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, assortmentSettings, &assortmentSettings.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (assortmentSettings AssortmentSettings) MarshalJSON() ([]byte, error) {
+	type alias AssortmentSettings
+	return marshalUnknownFields(alias(assortmentSettings), assortmentSettings.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (attribute *Attribute) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, attribute, &attribute.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (attribute Attribute) MarshalJSON() ([]byte, error) {
+	type alias Attribute
+	return marshalUnknownFields(alias(attribute), attribute.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (bonusProgram *BonusProgram) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, bonusProgram, &bonusProgram.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (bonusProgram BonusProgram) MarshalJSON() ([]byte, error) {
+	type alias BonusProgram
+	return marshalUnknownFields(alias(bonusProgram), bonusProgram.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (bonusTransaction *BonusTransaction) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, bonusTransaction, &bonusTransaction.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (bonusTransaction BonusTransaction) MarshalJSON() ([]byte, error) {
+	type alias BonusTransaction
+	return marshalUnknownFields(alias(bonusTransaction), bonusTransaction.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (bundle *Bundle) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, bundle, &bundle.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (bundle Bundle) MarshalJSON() ([]byte, error) {
+	type alias Bundle
+	return marshalUnknownFields(alias(bundle), bundle.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (bundleComponent *BundleComponent) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, bundleComponent, &bundleComponent.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (bundleComponent BundleComponent) MarshalJSON() ([]byte, error) {
+	type alias BundleComponent
+	return marshalUnknownFields(alias(bundleComponent), bundleComponent.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (cashIn *CashIn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, cashIn, &cashIn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (cashIn CashIn) MarshalJSON() ([]byte, error) {
+	type alias CashIn
+	return marshalUnknownFields(alias(cashIn), cashIn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (cashOut *CashOut) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, cashOut, &cashOut.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (cashOut CashOut) MarshalJSON() ([]byte, error) {
+	type alias CashOut
+	return marshalUnknownFields(alias(cashOut), cashOut.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (cashier *Cashier) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, cashier, &cashier.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (cashier Cashier) MarshalJSON() ([]byte, error) {
+	type alias Cashier
+	return marshalUnknownFields(alias(cashier), cashier.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (cashierRole *CashierRole) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, cashierRole, &cashierRole.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (cashierRole CashierRole) MarshalJSON() ([]byte, error) {
+	type alias CashierRole
+	return marshalUnknownFields(alias(cashierRole), cashierRole.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (characteristic *Characteristic) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, characteristic, &characteristic.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (characteristic Characteristic) MarshalJSON() ([]byte, error) {
+	type alias Characteristic
+	return marshalUnknownFields(alias(characteristic), characteristic.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (commissionReportIn *CommissionReportIn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, commissionReportIn, &commissionReportIn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (commissionReportIn CommissionReportIn) MarshalJSON() ([]byte, error) {
+	type alias CommissionReportIn
+	return marshalUnknownFields(alias(commissionReportIn), commissionReportIn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (commissionReportInPosition *CommissionReportInPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, commissionReportInPosition, &commissionReportInPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (commissionReportInPosition CommissionReportInPosition) MarshalJSON() ([]byte, error) {
+	type alias CommissionReportInPosition
+	return marshalUnknownFields(alias(commissionReportInPosition), commissionReportInPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (commissionReportInReturnPosition *CommissionReportInReturnPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, commissionReportInReturnPosition, &commissionReportInReturnPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (commissionReportInReturnPosition CommissionReportInReturnPosition) MarshalJSON() ([]byte, error) {
+	type alias CommissionReportInReturnPosition
+	return marshalUnknownFields(alias(commissionReportInReturnPosition), commissionReportInReturnPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (commissionReportOut *CommissionReportOut) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, commissionReportOut, &commissionReportOut.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (commissionReportOut CommissionReportOut) MarshalJSON() ([]byte, error) {
+	type alias CommissionReportOut
+	return marshalUnknownFields(alias(commissionReportOut), commissionReportOut.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (commissionReportOutPosition *CommissionReportOutPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, commissionReportOutPosition, &commissionReportOutPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (commissionReportOutPosition CommissionReportOutPosition) MarshalJSON() ([]byte, error) {
+	type alias CommissionReportOutPosition
+	return marshalUnknownFields(alias(commissionReportOutPosition), commissionReportOutPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (companySettings *CompanySettings) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, companySettings, &companySettings.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (companySettings CompanySettings) MarshalJSON() ([]byte, error) {
+	type alias CompanySettings
+	return marshalUnknownFields(alias(companySettings), companySettings.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (consignment *Consignment) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, consignment, &consignment.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (consignment Consignment) MarshalJSON() ([]byte, error) {
+	type alias Consignment
+	return marshalUnknownFields(alias(consignment), consignment.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (contactPerson *ContactPerson) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, contactPerson, &contactPerson.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (contactPerson ContactPerson) MarshalJSON() ([]byte, error) {
+	type alias ContactPerson
+	return marshalUnknownFields(alias(contactPerson), contactPerson.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (contextEmployee *ContextEmployee) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, contextEmployee, &contextEmployee.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (contextEmployee ContextEmployee) MarshalJSON() ([]byte, error) {
+	type alias ContextEmployee
+	return marshalUnknownFields(alias(contextEmployee), contextEmployee.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (contract *Contract) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, contract, &contract.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (contract Contract) MarshalJSON() ([]byte, error) {
+	type alias Contract
+	return marshalUnknownFields(alias(contract), contract.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (counterparty *Counterparty) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, counterparty, &counterparty.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (counterparty Counterparty) MarshalJSON() ([]byte, error) {
+	type alias Counterparty
+	return marshalUnknownFields(alias(counterparty), counterparty.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (counterPartyAdjustment *CounterpartyAdjustment) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, counterPartyAdjustment, &counterPartyAdjustment.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (counterPartyAdjustment CounterpartyAdjustment) MarshalJSON() ([]byte, error) {
+	type alias CounterpartyAdjustment
+	return marshalUnknownFields(alias(counterPartyAdjustment), counterPartyAdjustment.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (counterpartySettings *CounterpartySettings) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, counterpartySettings, &counterpartySettings.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (counterpartySettings CounterpartySettings) MarshalJSON() ([]byte, error) {
+	type alias CounterpartySettings
+	return marshalUnknownFields(alias(counterpartySettings), counterpartySettings.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (country *Country) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, country, &country.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (country Country) MarshalJSON() ([]byte, error) {
+	type alias Country
+	return marshalUnknownFields(alias(country), country.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (currency *Currency) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, currency, &currency.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (currency Currency) MarshalJSON() ([]byte, error) {
+	type alias Currency
+	return marshalUnknownFields(alias(currency), currency.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (customEntity *CustomEntity) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, customEntity, &customEntity.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (customEntity CustomEntity) MarshalJSON() ([]byte, error) {
+	type alias CustomEntity
+	return marshalUnknownFields(alias(customEntity), customEntity.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (customEntityElement *CustomEntityElement) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, customEntityElement, &customEntityElement.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (customEntityElement CustomEntityElement) MarshalJSON() ([]byte, error) {
+	type alias CustomEntityElement
+	return marshalUnknownFields(alias(customEntityElement), customEntityElement.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (customerOrder *CustomerOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, customerOrder, &customerOrder.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (customerOrder CustomerOrder) MarshalJSON() ([]byte, error) {
+	type alias CustomerOrder
+	return marshalUnknownFields(alias(customerOrder), customerOrder.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (customerOrderPosition *CustomerOrderPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, customerOrderPosition, &customerOrderPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (customerOrderPosition CustomerOrderPosition) MarshalJSON() ([]byte, error) {
+	type alias CustomerOrderPosition
+	return marshalUnknownFields(alias(customerOrderPosition), customerOrderPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (demand *Demand) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, demand, &demand.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (demand Demand) MarshalJSON() ([]byte, error) {
+	type alias Demand
+	return marshalUnknownFields(alias(demand), demand.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (demandPosition *DemandPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, demandPosition, &demandPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (demandPosition DemandPosition) MarshalJSON() ([]byte, error) {
+	type alias DemandPosition
+	return marshalUnknownFields(alias(demandPosition), demandPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (employee *Employee) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, employee, &employee.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (employee Employee) MarshalJSON() ([]byte, error) {
+	type alias Employee
+	return marshalUnknownFields(alias(employee), employee.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (enter *Enter) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, enter, &enter.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (enter Enter) MarshalJSON() ([]byte, error) {
+	type alias Enter
+	return marshalUnknownFields(alias(enter), enter.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (enterPosition *EnterPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, enterPosition, &enterPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (enterPosition EnterPosition) MarshalJSON() ([]byte, error) {
+	type alias EnterPosition
+	return marshalUnknownFields(alias(enterPosition), enterPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (expenseItem *ExpenseItem) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, expenseItem, &expenseItem.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (expenseItem ExpenseItem) MarshalJSON() ([]byte, error) {
+	type alias ExpenseItem
+	return marshalUnknownFields(alias(expenseItem), expenseItem.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (factureIn *FactureIn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, factureIn, &factureIn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (factureIn FactureIn) MarshalJSON() ([]byte, error) {
+	type alias FactureIn
+	return marshalUnknownFields(alias(factureIn), factureIn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (factureOut *FactureOut) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, factureOut, &factureOut.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (factureOut FactureOut) MarshalJSON() ([]byte, error) {
+	type alias FactureOut
+	return marshalUnknownFields(alias(factureOut), factureOut.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (file *File) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, file, &file.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (file File) MarshalJSON() ([]byte, error) {
+	type alias File
+	return marshalUnknownFields(alias(file), file.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (group *Group) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, group, &group.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (group Group) MarshalJSON() ([]byte, error) {
+	type alias Group
+	return marshalUnknownFields(alias(group), group.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (image *Image) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, image, &image.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (image Image) MarshalJSON() ([]byte, error) {
+	type alias Image
+	return marshalUnknownFields(alias(image), image.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (individualRole *IndividualRole) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, individualRole, &individualRole.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (individualRole IndividualRole) MarshalJSON() ([]byte, error) {
+	type alias IndividualRole
+	return marshalUnknownFields(alias(individualRole), individualRole.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (internalOrder *InternalOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, internalOrder, &internalOrder.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (internalOrder InternalOrder) MarshalJSON() ([]byte, error) {
+	type alias InternalOrder
+	return marshalUnknownFields(alias(internalOrder), internalOrder.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (internalOrderPosition *InternalOrderPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, internalOrderPosition, &internalOrderPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (internalOrderPosition InternalOrderPosition) MarshalJSON() ([]byte, error) {
+	type alias InternalOrderPosition
+	return marshalUnknownFields(alias(internalOrderPosition), internalOrderPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (inventory *Inventory) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, inventory, &inventory.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (inventory Inventory) MarshalJSON() ([]byte, error) {
+	type alias Inventory
+	return marshalUnknownFields(alias(inventory), inventory.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (inventoryPosition *InventoryPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, inventoryPosition, &inventoryPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (inventoryPosition InventoryPosition) MarshalJSON() ([]byte, error) {
+	type alias InventoryPosition
+	return marshalUnknownFields(alias(inventoryPosition), inventoryPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (invoiceIn *InvoiceIn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, invoiceIn, &invoiceIn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (invoiceIn InvoiceIn) MarshalJSON() ([]byte, error) {
+	type alias InvoiceIn
+	return marshalUnknownFields(alias(invoiceIn), invoiceIn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (invoiceInPosition *InvoiceInPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, invoiceInPosition, &invoiceInPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (invoiceInPosition InvoiceInPosition) MarshalJSON() ([]byte, error) {
+	type alias InvoiceInPosition
+	return marshalUnknownFields(alias(invoiceInPosition), invoiceInPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (invoiceOut *InvoiceOut) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, invoiceOut, &invoiceOut.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (invoiceOut InvoiceOut) MarshalJSON() ([]byte, error) {
+	type alias InvoiceOut
+	return marshalUnknownFields(alias(invoiceOut), invoiceOut.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (invoiceOutPosition *InvoiceOutPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, invoiceOutPosition, &invoiceOutPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (invoiceOutPosition InvoiceOutPosition) MarshalJSON() ([]byte, error) {
+	type alias InvoiceOutPosition
+	return marshalUnknownFields(alias(invoiceOutPosition), invoiceOutPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (loss *Loss) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, loss, &loss.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (loss Loss) MarshalJSON() ([]byte, error) {
+	type alias Loss
+	return marshalUnknownFields(alias(loss), loss.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (lossPosition *LossPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, lossPosition, &lossPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (lossPosition LossPosition) MarshalJSON() ([]byte, error) {
+	type alias LossPosition
+	return marshalUnknownFields(alias(lossPosition), lossPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (move *Move) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, move, &move.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (move Move) MarshalJSON() ([]byte, error) {
+	type alias Move
+	return marshalUnknownFields(alias(move), move.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (movePosition *MovePosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, movePosition, &movePosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (movePosition MovePosition) MarshalJSON() ([]byte, error) {
+	type alias MovePosition
+	return marshalUnknownFields(alias(movePosition), movePosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (namedFilter *NamedFilter) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, namedFilter, &namedFilter.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (namedFilter NamedFilter) MarshalJSON() ([]byte, error) {
+	type alias NamedFilter
+	return marshalUnknownFields(alias(namedFilter), namedFilter.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (note *Note) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, note, &note.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (note Note) MarshalJSON() ([]byte, error) {
+	type alias Note
+	return marshalUnknownFields(alias(note), note.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (organization *Organization) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, organization, &organization.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (organization Organization) MarshalJSON() ([]byte, error) {
+	type alias Organization
+	return marshalUnknownFields(alias(organization), organization.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (pack *Pack) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, pack, &pack.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (pack Pack) MarshalJSON() ([]byte, error) {
+	type alias Pack
+	return marshalUnknownFields(alias(pack), pack.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (paymentIn *PaymentIn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, paymentIn, &paymentIn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (paymentIn PaymentIn) MarshalJSON() ([]byte, error) {
+	type alias PaymentIn
+	return marshalUnknownFields(alias(paymentIn), paymentIn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (paymentOut *PaymentOut) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, paymentOut, &paymentOut.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (paymentOut PaymentOut) MarshalJSON() ([]byte, error) {
+	type alias PaymentOut
+	return marshalUnknownFields(alias(paymentOut), paymentOut.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (payroll *Payroll) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, payroll, &payroll.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (payroll Payroll) MarshalJSON() ([]byte, error) {
+	type alias Payroll
+	return marshalUnknownFields(alias(payroll), payroll.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (personalDiscount *PersonalDiscount) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, personalDiscount, &personalDiscount.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (personalDiscount PersonalDiscount) MarshalJSON() ([]byte, error) {
+	type alias PersonalDiscount
+	return marshalUnknownFields(alias(personalDiscount), personalDiscount.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (prepayment *Prepayment) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, prepayment, &prepayment.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (prepayment Prepayment) MarshalJSON() ([]byte, error) {
+	type alias Prepayment
+	return marshalUnknownFields(alias(prepayment), prepayment.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (prepaymentPosition *PrepaymentPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, prepaymentPosition, &prepaymentPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (prepaymentPosition PrepaymentPosition) MarshalJSON() ([]byte, error) {
+	type alias PrepaymentPosition
+	return marshalUnknownFields(alias(prepaymentPosition), prepaymentPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (prepaymentReturn *PrepaymentReturn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, prepaymentReturn, &prepaymentReturn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (prepaymentReturn PrepaymentReturn) MarshalJSON() ([]byte, error) {
+	type alias PrepaymentReturn
+	return marshalUnknownFields(alias(prepaymentReturn), prepaymentReturn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (prepaymentReturnPosition *PrepaymentReturnPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, prepaymentReturnPosition, &prepaymentReturnPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (prepaymentReturnPosition PrepaymentReturnPosition) MarshalJSON() ([]byte, error) {
+	type alias PrepaymentReturnPosition
+	return marshalUnknownFields(alias(prepaymentReturnPosition), prepaymentReturnPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (priceList *PriceList) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, priceList, &priceList.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (priceList PriceList) MarshalJSON() ([]byte, error) {
+	type alias PriceList
+	return marshalUnknownFields(alias(priceList), priceList.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (priceListPosition *PriceListPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, priceListPosition, &priceListPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (priceListPosition PriceListPosition) MarshalJSON() ([]byte, error) {
+	type alias PriceListPosition
+	return marshalUnknownFields(alias(priceListPosition), priceListPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (priceType *PriceType) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, priceType, &priceType.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (priceType PriceType) MarshalJSON() ([]byte, error) {
+	type alias PriceType
+	return marshalUnknownFields(alias(priceType), priceType.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processing *Processing) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processing, &processing.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processing Processing) MarshalJSON() ([]byte, error) {
+	type alias Processing
+	return marshalUnknownFields(alias(processing), processing.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingOrder *ProcessingOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingOrder, &processingOrder.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingOrder ProcessingOrder) MarshalJSON() ([]byte, error) {
+	type alias ProcessingOrder
+	return marshalUnknownFields(alias(processingOrder), processingOrder.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingOrderPosition *ProcessingOrderPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingOrderPosition, &processingOrderPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingOrderPosition ProcessingOrderPosition) MarshalJSON() ([]byte, error) {
+	type alias ProcessingOrderPosition
+	return marshalUnknownFields(alias(processingOrderPosition), processingOrderPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingPlan *ProcessingPlan) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingPlan, &processingPlan.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingPlan ProcessingPlan) MarshalJSON() ([]byte, error) {
+	type alias ProcessingPlan
+	return marshalUnknownFields(alias(processingPlan), processingPlan.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingPlanFolder *ProcessingPlanFolder) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingPlanFolder, &processingPlanFolder.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingPlanFolder ProcessingPlanFolder) MarshalJSON() ([]byte, error) {
+	type alias ProcessingPlanFolder
+	return marshalUnknownFields(alias(processingPlanFolder), processingPlanFolder.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingPlanMaterial *ProcessingPlanMaterial) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingPlanMaterial, &processingPlanMaterial.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingPlanMaterial ProcessingPlanMaterial) MarshalJSON() ([]byte, error) {
+	type alias ProcessingPlanMaterial
+	return marshalUnknownFields(alias(processingPlanMaterial), processingPlanMaterial.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingPlanProduct *ProcessingPlanProduct) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingPlanProduct, &processingPlanProduct.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingPlanProduct ProcessingPlanProduct) MarshalJSON() ([]byte, error) {
+	type alias ProcessingPlanProduct
+	return marshalUnknownFields(alias(processingPlanProduct), processingPlanProduct.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingPlanStages *ProcessingPlanStages) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingPlanStages, &processingPlanStages.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingPlanStages ProcessingPlanStages) MarshalJSON() ([]byte, error) {
+	type alias ProcessingPlanStages
+	return marshalUnknownFields(alias(processingPlanStages), processingPlanStages.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingPositionMaterial *ProcessingPositionMaterial) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingPositionMaterial, &processingPositionMaterial.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingPositionMaterial ProcessingPositionMaterial) MarshalJSON() ([]byte, error) {
+	type alias ProcessingPositionMaterial
+	return marshalUnknownFields(alias(processingPositionMaterial), processingPositionMaterial.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingPositionProduct *ProcessingPositionProduct) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingPositionProduct, &processingPositionProduct.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingPositionProduct ProcessingPositionProduct) MarshalJSON() ([]byte, error) {
+	type alias ProcessingPositionProduct
+	return marshalUnknownFields(alias(processingPositionProduct), processingPositionProduct.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingProcess *ProcessingProcess) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingProcess, &processingProcess.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingProcess ProcessingProcess) MarshalJSON() ([]byte, error) {
+	type alias ProcessingProcess
+	return marshalUnknownFields(alias(processingProcess), processingProcess.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingProcessPosition *ProcessingProcessPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingProcessPosition, &processingProcessPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingProcessPosition ProcessingProcessPosition) MarshalJSON() ([]byte, error) {
+	type alias ProcessingProcessPosition
+	return marshalUnknownFields(alias(processingProcessPosition), processingProcessPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (processingStage *ProcessingStage) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, processingStage, &processingStage.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (processingStage ProcessingStage) MarshalJSON() ([]byte, error) {
+	type alias ProcessingStage
+	return marshalUnknownFields(alias(processingStage), processingStage.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (product *Product) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, product, &product.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (product Product) MarshalJSON() ([]byte, error) {
+	type alias Product
+	return marshalUnknownFields(alias(product), product.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productFolder *ProductFolder) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productFolder, &productFolder.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productFolder ProductFolder) MarshalJSON() ([]byte, error) {
+	type alias ProductFolder
+	return marshalUnknownFields(alias(productFolder), productFolder.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionRow *ProductionRow) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionRow, &productionRow.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionRow ProductionRow) MarshalJSON() ([]byte, error) {
+	type alias ProductionRow
+	return marshalUnknownFields(alias(productionRow), productionRow.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionStage *ProductionStage) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionStage, &productionStage.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionStage ProductionStage) MarshalJSON() ([]byte, error) {
+	type alias ProductionStage
+	return marshalUnknownFields(alias(productionStage), productionStage.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionStageCompletion *ProductionStageCompletion) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionStageCompletion, &productionStageCompletion.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionStageCompletion ProductionStageCompletion) MarshalJSON() ([]byte, error) {
+	type alias ProductionStageCompletion
+	return marshalUnknownFields(alias(productionStageCompletion), productionStageCompletion.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionStageCompletionMaterial *ProductionStageCompletionMaterial) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionStageCompletionMaterial, &productionStageCompletionMaterial.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionStageCompletionMaterial ProductionStageCompletionMaterial) MarshalJSON() ([]byte, error) {
+	type alias ProductionStageCompletionMaterial
+	return marshalUnknownFields(alias(productionStageCompletionMaterial), productionStageCompletionMaterial.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionStageCompletionResult *ProductionStageCompletionResult) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionStageCompletionResult, &productionStageCompletionResult.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionStageCompletionResult ProductionStageCompletionResult) MarshalJSON() ([]byte, error) {
+	type alias ProductionStageCompletionResult
+	return marshalUnknownFields(alias(productionStageCompletionResult), productionStageCompletionResult.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionTask *ProductionTask) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionTask, &productionTask.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionTask ProductionTask) MarshalJSON() ([]byte, error) {
+	type alias ProductionTask
+	return marshalUnknownFields(alias(productionTask), productionTask.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionTaskMaterial *ProductionTaskMaterial) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionTaskMaterial, &productionTaskMaterial.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionTaskMaterial ProductionTaskMaterial) MarshalJSON() ([]byte, error) {
+	type alias ProductionTaskMaterial
+	return marshalUnknownFields(alias(productionTaskMaterial), productionTaskMaterial.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (productionTaskResult *ProductionTaskResult) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, productionTaskResult, &productionTaskResult.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (productionTaskResult ProductionTaskResult) MarshalJSON() ([]byte, error) {
+	type alias ProductionTaskResult
+	return marshalUnknownFields(alias(productionTaskResult), productionTaskResult.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (project *Project) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, project, &project.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (project Project) MarshalJSON() ([]byte, error) {
+	type alias Project
+	return marshalUnknownFields(alias(project), project.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (publication *Publication) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, publication, &publication.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (publication Publication) MarshalJSON() ([]byte, error) {
+	type alias Publication
+	return marshalUnknownFields(alias(publication), publication.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (purchaseOrder *PurchaseOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, purchaseOrder, &purchaseOrder.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (purchaseOrder PurchaseOrder) MarshalJSON() ([]byte, error) {
+	type alias PurchaseOrder
+	return marshalUnknownFields(alias(purchaseOrder), purchaseOrder.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (purchaseOrderPosition *PurchaseOrderPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, purchaseOrderPosition, &purchaseOrderPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (purchaseOrderPosition PurchaseOrderPosition) MarshalJSON() ([]byte, error) {
+	type alias PurchaseOrderPosition
+	return marshalUnknownFields(alias(purchaseOrderPosition), purchaseOrderPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (purchaseReturn *PurchaseReturn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, purchaseReturn, &purchaseReturn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (purchaseReturn PurchaseReturn) MarshalJSON() ([]byte, error) {
+	type alias PurchaseReturn
+	return marshalUnknownFields(alias(purchaseReturn), purchaseReturn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (purchaseReturnPosition *PurchaseReturnPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, purchaseReturnPosition, &purchaseReturnPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (purchaseReturnPosition PurchaseReturnPosition) MarshalJSON() ([]byte, error) {
+	type alias PurchaseReturnPosition
+	return marshalUnknownFields(alias(purchaseReturnPosition), purchaseReturnPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (region *Region) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, region, &region.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (region Region) MarshalJSON() ([]byte, error) {
+	type alias Region
+	return marshalUnknownFields(alias(region), region.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailDemand *RetailDemand) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailDemand, &retailDemand.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailDemand RetailDemand) MarshalJSON() ([]byte, error) {
+	type alias RetailDemand
+	return marshalUnknownFields(alias(retailDemand), retailDemand.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailDemandPosition *RetailDemandPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailDemandPosition, &retailDemandPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailDemandPosition RetailDemandPosition) MarshalJSON() ([]byte, error) {
+	type alias RetailDemandPosition
+	return marshalUnknownFields(alias(retailDemandPosition), retailDemandPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailDrawerCashIn *RetailDrawerCashIn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailDrawerCashIn, &retailDrawerCashIn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailDrawerCashIn RetailDrawerCashIn) MarshalJSON() ([]byte, error) {
+	type alias RetailDrawerCashIn
+	return marshalUnknownFields(alias(retailDrawerCashIn), retailDrawerCashIn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailDrawerCashOut *RetailDrawerCashOut) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailDrawerCashOut, &retailDrawerCashOut.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailDrawerCashOut RetailDrawerCashOut) MarshalJSON() ([]byte, error) {
+	type alias RetailDrawerCashOut
+	return marshalUnknownFields(alias(retailDrawerCashOut), retailDrawerCashOut.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailSalesReturn *RetailSalesReturn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailSalesReturn, &retailSalesReturn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailSalesReturn RetailSalesReturn) MarshalJSON() ([]byte, error) {
+	type alias RetailSalesReturn
+	return marshalUnknownFields(alias(retailSalesReturn), retailSalesReturn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailSalesReturnPosition *RetailSalesReturnPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailSalesReturnPosition, &retailSalesReturnPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailSalesReturnPosition RetailSalesReturnPosition) MarshalJSON() ([]byte, error) {
+	type alias RetailSalesReturnPosition
+	return marshalUnknownFields(alias(retailSalesReturnPosition), retailSalesReturnPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailShift *RetailShift) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailShift, &retailShift.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailShift RetailShift) MarshalJSON() ([]byte, error) {
+	type alias RetailShift
+	return marshalUnknownFields(alias(retailShift), retailShift.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (retailStore *RetailStore) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, retailStore, &retailStore.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (retailStore RetailStore) MarshalJSON() ([]byte, error) {
+	type alias RetailStore
+	return marshalUnknownFields(alias(retailStore), retailStore.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (role *Role) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, role, &role.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (role Role) MarshalJSON() ([]byte, error) {
+	type alias Role
+	return marshalUnknownFields(alias(role), role.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (salesChannel *SalesChannel) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, salesChannel, &salesChannel.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (salesChannel SalesChannel) MarshalJSON() ([]byte, error) {
+	type alias SalesChannel
+	return marshalUnknownFields(alias(salesChannel), salesChannel.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (salesReturn *SalesReturn) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, salesReturn, &salesReturn.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (salesReturn SalesReturn) MarshalJSON() ([]byte, error) {
+	type alias SalesReturn
+	return marshalUnknownFields(alias(salesReturn), salesReturn.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (salesReturnPosition *SalesReturnPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, salesReturnPosition, &salesReturnPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (salesReturnPosition SalesReturnPosition) MarshalJSON() ([]byte, error) {
+	type alias SalesReturnPosition
+	return marshalUnknownFields(alias(salesReturnPosition), salesReturnPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (service *Service) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, service, &service.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (service Service) MarshalJSON() ([]byte, error) {
+	type alias Service
+	return marshalUnknownFields(alias(service), service.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (slot *Slot) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, slot, &slot.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (slot Slot) MarshalJSON() ([]byte, error) {
+	type alias Slot
+	return marshalUnknownFields(alias(slot), slot.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (specialPriceDiscount *SpecialPriceDiscount) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, specialPriceDiscount, &specialPriceDiscount.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (specialPriceDiscount SpecialPriceDiscount) MarshalJSON() ([]byte, error) {
+	type alias SpecialPriceDiscount
+	return marshalUnknownFields(alias(specialPriceDiscount), specialPriceDiscount.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (state *State) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, state, &state.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (state State) MarshalJSON() ([]byte, error) {
+	type alias State
+	return marshalUnknownFields(alias(state), state.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (store *Store) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, store, &store.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (store Store) MarshalJSON() ([]byte, error) {
+	type alias Store
+	return marshalUnknownFields(alias(store), store.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (subscription *Subscription) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, subscription, &subscription.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (subscription Subscription) MarshalJSON() ([]byte, error) {
+	type alias Subscription
+	return marshalUnknownFields(alias(subscription), subscription.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (supply *Supply) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, supply, &supply.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (supply Supply) MarshalJSON() ([]byte, error) {
+	type alias Supply
+	return marshalUnknownFields(alias(supply), supply.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (supplyPosition *SupplyPosition) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, supplyPosition, &supplyPosition.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (supplyPosition SupplyPosition) MarshalJSON() ([]byte, error) {
+	type alias SupplyPosition
+	return marshalUnknownFields(alias(supplyPosition), supplyPosition.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (task *Task) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, task, &task.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (task Task) MarshalJSON() ([]byte, error) {
+	type alias Task
+	return marshalUnknownFields(alias(task), task.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (taskNote *TaskNote) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, taskNote, &taskNote.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (taskNote TaskNote) MarshalJSON() ([]byte, error) {
+	type alias TaskNote
+	return marshalUnknownFields(alias(taskNote), taskNote.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (taxRate *TaxRate) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, taxRate, &taxRate.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (taxRate TaxRate) MarshalJSON() ([]byte, error) {
+	type alias TaxRate
+	return marshalUnknownFields(alias(taxRate), taxRate.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (thing *Thing) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, thing, &thing.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (thing Thing) MarshalJSON() ([]byte, error) {
+	type alias Thing
+	return marshalUnknownFields(alias(thing), thing.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (trackingCode *TrackingCode) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, trackingCode, &trackingCode.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (trackingCode TrackingCode) MarshalJSON() ([]byte, error) {
+	type alias TrackingCode
+	return marshalUnknownFields(alias(trackingCode), trackingCode.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (uom *Uom) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, uom, &uom.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (uom Uom) MarshalJSON() ([]byte, error) {
+	type alias Uom
+	return marshalUnknownFields(alias(uom), uom.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (userSettings *UserSettings) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, userSettings, &userSettings.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (userSettings UserSettings) MarshalJSON() ([]byte, error) {
+	type alias UserSettings
+	return marshalUnknownFields(alias(userSettings), userSettings.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (variant *Variant) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, variant, &variant.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (variant Variant) MarshalJSON() ([]byte, error) {
+	type alias Variant
+	return marshalUnknownFields(alias(variant), variant.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (variantPack *VariantPack) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, variantPack, &variantPack.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (variantPack VariantPack) MarshalJSON() ([]byte, error) {
+	type alias VariantPack
+	return marshalUnknownFields(alias(variantPack), variantPack.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (webhook *Webhook) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, webhook, &webhook.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (workerRole *WorkerRole) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, workerRole, &workerRole.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (workerRole WorkerRole) MarshalJSON() ([]byte, error) {
+	type alias WorkerRole
+	return marshalUnknownFields(alias(workerRole), workerRole.unknownFields)
+}
+
+// UnmarshalJSON реализует интерфейс [json.Unmarshaler].
+func (zone *Zone) UnmarshalJSON(data []byte) error {
+	return unmarshalUnknownFields(data, zone, &zone.unknownFields)
+}
+
+// MarshalJSON реализует интерфейс [json.Marshaler].
+func (zone Zone) MarshalJSON() ([]byte, error) {
+	type alias Zone
+	return marshalUnknownFields(alias(zone), zone.unknownFields)
+}
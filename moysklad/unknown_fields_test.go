@@ -0,0 +1,80 @@
+package moysklad_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/EnOane/go-moysklad/moysklad"
+)
+
+func TestUnknownFields(t *testing.T) {
+	data := []byte(`{"name":"Товар","NEWFIELD":{"a":[1,"}"]},"Code":"001","newFlag":true}`)
+
+	var product moysklad.Product
+	if err := json.Unmarshal(data, &product); err != nil {
+		t.Fatal(err)
+	}
+
+	if product.GetName() != "Товар" || product.GetCode() != "001" {
+		t.Errorf("known fields = %q, %q", product.GetName(), product.GetCode())
+	}
+
+	unknown := product.UnknownFields()
+	if len(unknown) != 2 || string(unknown["NEWFIELD"]) != `{"a":[1,"}"]}` || string(unknown["newFlag"]) != "true" {
+		t.Errorf("UnknownFields() = %s", unknown)
+	}
+
+	encoded, err := json.Marshal(product)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"code":"001","name":"Товар"}`; string(encoded) != want {
+		t.Errorf("json.Marshal = %s, want %s", encoded, want)
+	}
+
+	product.KeepUnknownFields(true)
+	encoded, err = json.Marshal(product)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"code":"001","name":"Товар","NEWFIELD":{"a":[1,"}"]},"newFlag":true}`; string(encoded) != want {
+		t.Errorf("json.Marshal with kept fields = %s, want %s", encoded, want)
+	}
+}
+
+func TestUnknownFieldsErrors(t *testing.T) {
+	for _, data := range []string{`[]`, `"product"`, `{"name":1}`, `{"name":`} {
+		var product moysklad.Product
+		if err := json.Unmarshal([]byte(data), &product); err == nil {
+			t.Errorf("json.Unmarshal(%s) returned no error", data)
+		}
+	}
+
+	var product moysklad.Product
+	if err := json.Unmarshal([]byte(`null`), &product); err != nil {
+		t.Errorf("json.Unmarshal(null) = %v", err)
+	}
+}
+
+func TestUnknownFieldsComparable(t *testing.T) {
+	for _, typ := range []reflect.Type{
+		reflect.TypeFor[moysklad.Currency](),
+		reflect.TypeFor[moysklad.Uom](),
+		reflect.TypeFor[moysklad.CustomerOrderPosition](),
+	} {
+		if !typ.Comparable() {
+			t.Errorf("%s is not comparable", typ)
+		}
+	}
+
+	var a, b moysklad.Uom
+	for _, uom := range []*moysklad.Uom{&a, &b} {
+		if err := json.Unmarshal([]byte(`{"newField":1}`), uom); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if a != b {
+		t.Error("units of measure with equal unknown fields are not ==")
+	}
+}
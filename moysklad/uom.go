@@ -3,6 +3,7 @@ package moysklad
 import (
 	"context"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -26,6 +27,8 @@ type Uom struct {
 	Owner        *Employee  `json:"owner,omitempty"`        // Метаданные владельца (Сотрудника)
 	Shared       *bool      `json:"shared,omitempty"`       // Общий доступ
 	Updated      *Timestamp `json:"updated,omitempty"`      // Момент последнего обновления Единицы измерения
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -159,6 +162,11 @@ type UomService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Uom], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех единиц измерения постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Uom, error]
+
 	// Create выполняет запрос на создание единицы измерения.
 	// Обязательные поля для заполнения:
 	//	- name (Наименование единицы измерения)
@@ -26,6 +26,8 @@ type UserSettings struct {
 	MailFooter                  *string       `json:"mailFooter,omitempty"`                  // Подставляется в подпись в письмах, отправляемых из МС
 	Meta                        *Meta         `json:"meta,omitempty"`                        // Метаданные настроек
 	PrintFormat                 PrintFormat   `json:"printFormat,omitempty"`                 // Правила печати документов
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAutoShowReports возвращает флаг построения отчётов автоматически при переходе на вкладку с отчётом.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-resty/resty/v2"
+	"iter"
 
 	"time"
 )
@@ -35,6 +36,8 @@ type Variant struct {
 	SalePrices         Slice[SalePrice]      `json:"salePrices,omitempty"`         // Цены продажи
 	Things             Slice[string]         `json:"things,omitempty"`             // Серийные номера
 	Packs              Slice[VariantPack]    `json:"packs,omitempty"`              // Упаковки модификации
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // Clean возвращает указатель на объект с единственным заполненным полем [Meta].
@@ -307,6 +310,8 @@ type VariantPack struct {
 	ID         *string        `json:"id,omitempty"`         // ID упаковки модификации
 	ParentPack *Pack          `json:"parentpack,omitempty"` // Метаданные родительской упаковки (упаковки товара), для которой переопределяется штрихкод
 	Barcodes   Slice[Barcode] `json:"barcodes,omitempty"`   // Массив штрихкодов упаковки модификации. Данный массив может содержать только один штрихкод
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetID возвращает ID упаковки модификации.
@@ -367,6 +372,8 @@ type Characteristic struct {
 	Required *bool   `json:"required,omitempty"` // Флаг о том, является ли характеристика обязательной
 	Type     *string `json:"type,omitempty"`     // Тип значения характеристики (значение всегда "string")
 	Value    *string `json:"value,omitempty"`    // Значение характеристики
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetID возвращает ID соответствующей характеристики.
@@ -446,6 +453,11 @@ type VariantService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Variant], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех модификаций постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Variant, error]
+
 	// Create выполняет запрос на создание заказа модификации.
 	// Обязательные поля для заполнения:
 	//	- product (Метаданные товара, к которому привязана Модификация)
@@ -2,8 +2,8 @@ package moysklad
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // Webhook Вебхук.
@@ -25,6 +25,8 @@ type Webhook struct {
 	Method            *string       `json:"method,omitempty"`            // HTTP метод, с которым будет происходить запрос
 	URL               *string       `json:"url,omitempty"`               // URL, по которому будет происходить запрос. Допустимая длина до 255 символов
 	UpdatedFields     Slice[string] `json:"updatedFields,omitempty"`     // Поля сущности, измененные пользователем
+
+	unknownFields // Поля JSON, не описанные в структуре
 }
 
 // GetAccountID возвращает ID учётной записи.
@@ -179,8 +181,9 @@ func (webhook *Webhook) Delete(ctx context.Context, client *Client) (bool, *rest
 
 // MarshalJSON реализует интерфейс [json.Marshaler].
 func (webhook Webhook) MarshalJSON() ([]byte, error) {
+	type alias Webhook
 	webhook.Method = String("POST")
-	return json.Marshal(webhook)
+	return marshalUnknownFields(alias(webhook), webhook.unknownFields)
 }
 
 // WebhookAction Действие, которое отслеживается веб-хуком.
@@ -229,6 +232,11 @@ type WebhookService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[Webhook], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех вебхуков постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*Webhook, error]
+
 	// Create выполняет запрос на создание вебхука.
 	// Обязательные поля для заполнения:
 	//	- entityType (Тип сущности, к которой привязан вебхук)
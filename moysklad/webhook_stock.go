@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/go-resty/resty/v2"
+	"iter"
 )
 
 // WebhookStock Вебхук на изменение остатков.
@@ -167,6 +168,11 @@ type WebhookStockService interface {
 	// Возвращает список объектов.
 	GetListAll(ctx context.Context, params ...func(*Params)) (*Slice[WebhookStock], *resty.Response, error)
 
+	// GetListSeq возвращает итератор для получения всех вебхуков на изменение остатков постранично по мере обхода.
+	// Принимает контекст и опционально объект параметров запроса Params.
+	// Возвращает итератор, который вторым значением передаёт ошибку запроса страницы.
+	GetListSeq(ctx context.Context, params ...func(*Params)) iter.Seq2[*WebhookStock, error]
+
 	// Create выполняет запрос на создание вебхука на изменение остатков.
 	// Обязательные поля для заполнения:
 	//	- reportType (Тип отчета остатков, к которым привязан вебхук на изменение остатков)